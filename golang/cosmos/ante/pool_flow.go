@@ -0,0 +1,29 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+)
+
+// PoolFlowCategoryDecorator attributes the coin movements of an inner
+// decorator (such as the fee deduction) to a vbank pool flow category, without
+// leaking that category into the rest of the chain.
+type PoolFlowCategoryDecorator struct {
+	category string
+	inner    sdk.AnteDecorator
+}
+
+func NewPoolFlowCategoryDecorator(category string, inner sdk.AnteDecorator) PoolFlowCategoryDecorator {
+	return PoolFlowCategoryDecorator{category: category, inner: inner}
+}
+
+// AnteHandle calls the inner decorator with the category set, and the next
+// AnteHandler with the category that was set before.
+func (pd PoolFlowCategoryDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	prior := agoric.PoolFlowCategory(ctx)
+	innerNext := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return next(agoric.WithPoolFlowCategory(ctx, prior), tx, simulate)
+	}
+	return pd.inner.AnteHandle(agoric.WithPoolFlowCategory(ctx, pd.category), tx, simulate, innerNext)
+}
@@ -0,0 +1,43 @@
+package ante
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+)
+
+type categoryRecordingDecorator struct {
+	category *string
+}
+
+func (cd categoryRecordingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*cd.category = agoric.PoolFlowCategory(ctx)
+	return next(ctx, tx, simulate)
+}
+
+func TestPoolFlowCategoryAnteHandle(t *testing.T) {
+	var innerCategory, nextCategory string
+	decorator := NewPoolFlowCategoryDecorator(agoric.PoolFlowCategoryTxFee, categoryRecordingDecorator{&innerCategory})
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCategory = agoric.PoolFlowCategory(ctx)
+		return ctx, nil
+	}
+
+	ctx := agoric.WithPoolFlowCategory(sdk.Context{}.WithContext(context.Background()), "prior")
+	newCtx, err := decorator.AnteHandle(ctx, makeTestTx(), false, next)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if innerCategory != agoric.PoolFlowCategoryTxFee {
+		t.Errorf("inner decorator got category %q, want %q", innerCategory, agoric.PoolFlowCategoryTxFee)
+	}
+	if nextCategory != "prior" {
+		t.Errorf("next handler got category %q, want %q", nextCategory, "prior")
+	}
+	if got := agoric.PoolFlowCategory(newCtx); got != "prior" {
+		t.Errorf("returned context has category %q, want %q", got, "prior")
+	}
+}
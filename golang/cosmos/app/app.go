@@ -112,6 +112,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
+	"go.opentelemetry.io/otel"
 
 	appante "github.com/Agoric/agoric-sdk/golang/cosmos/ante"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/txconfig"
@@ -180,6 +181,7 @@ var (
 		vbanktypes.ReservePoolName:     nil,
 		vbanktypes.ProvisionPoolName:   nil,
 		vbanktypes.GiveawayPoolName:    nil,
+		vbanktypes.HoldPoolName:        nil,
 	}
 )
 
@@ -513,6 +515,7 @@ func NewAgoricApp(
 		callToController,
 	)
 	app.swingsetPort = app.AgdServer.MustRegisterPortHandler("swingset", swingset.NewPortHandler(app.SwingSetKeeper))
+	app.AgdServer.SetPortCallBudgets(app.SwingSetKeeper.GetPortCallBudget)
 
 	app.SwingStoreExportsHandler = *swingsetkeeper.NewSwingStoreExportsHandler(
 		app.Logger(),
@@ -568,12 +571,20 @@ func NewAgoricApp(
 
 	app.VibcKeeper = vibc.NewKeeper(
 		appCodec,
+		runtime.NewKVStoreService(keys[vibc.StoreKey]),
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.ClientKeeper,
-	).WithScope(vibcScope)
+	).WithScope(vibcScope).
+		WithAuthority(authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	vibcModule := vibc.NewAppModule(app.VibcKeeper, app.BankKeeper)
-	vibcIBCModule := vibc.NewIBCModule(app.VibcKeeper)
+	// The vibc stack has no ICS-29 fee middleware, since ibc-go v10 removed it
+	// (along with the 29-fee module).  Relayer incentives for VM-owned channels
+	// must come from outside the IBC stack.
+	// The VM chooses the versions of its channels asynchronously, completing
+	// each handshake step with "initOpenExecuted", "tryOpenExecuted" or
+	// "ackOpenExecuted" after ibc-go has written it.
+	vibcIBCModule := vibc.NewIBCModule(app.VibcKeeper).WithAsyncVersions(true)
 	vibcScope.SetDynamicModule(vibcIBCModule)
 	vibcDynamicRouter.AddLegacyPrefixRoute("icacontroller-", vibcIBCModule)
 	vibcDynamicRouter.AddLegacyPrefixRoute("icqcontroller-", vibcIBCModule)
@@ -600,7 +611,8 @@ func NewAgoricApp(
 		app.GetSubspace(vbank.ModuleName),
 		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
 		app.SwingSetKeeper.PushAction,
-	)
+	).WithDistributionKeeper(app.DistrKeeper).
+		WithAuthority(authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	vbankModule := vbank.NewAppModule(app.VbankKeeper)
 	app.vbankPort = app.AgdServer.MustRegisterPortHandler("bank", vbank.NewPortHandler(vbankModule, app.VbankKeeper))
 
@@ -657,6 +669,7 @@ func NewAgoricApp(
 	)
 
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
+	app.VbankKeeper.SetTransferKeeper(app.TransferKeeper)
 
 	// NewAppModule uses a pointer to the host keeper in case there's a need to
 	// tie a circular knot with IBC middleware before icahostkeeper.NewKeeper
@@ -1032,8 +1045,15 @@ type cosmosInitAction struct {
 	ResolvedConfig  *swingset.SwingsetConfig `json:"resolvedConfig"`
 	SupplyCoins     sdk.Coins                `json:"supplyCoins"`
 	UpgradeDetails  *upgradeDetails          `json:"upgradeDetails,omitempty"`
+	// PortEncodings lists the bridge ports that accept Message.Data encodings
+	// besides JSON, keyed by port number.  chain-main.js sends CBOR only to
+	// these ports, and only if AG_COSMOS_BRIDGE_ENCODING asks for it.
+	PortEncodings map[int][]vm.Encoding `json:"portEncodings,omitempty"`
 	// CAVEAT: Every property ending in "Port" is saved in chain-main.js/portNums
-	// with a key consisting of this name with the "Port" stripped.
+	// with a key consisting of this name with the "Port" stripped.  Ports for
+	// new Go modules need not be added here, because the VM can discover them
+	// with the "listPorts" method of AgdServerPort.
+	AgdServerPort   int `json:"agdServerPort"`
 	StoragePort     int `json:"storagePort"`
 	SwingsetPort    int `json:"swingsetPort"`
 	VbankPort       int `json:"vbankPort"`
@@ -1042,6 +1062,10 @@ type cosmosInitAction struct {
 	VtransferPort   int `json:"vtransferPort"`
 }
 
+func init() {
+	vm.RegisterAction(&cosmosInitAction{})
+}
+
 // Name returns the name of the App
 func (app *GaiaApp) Name() string { return app.BaseApp.Name() }
 
@@ -1079,6 +1103,9 @@ func (app *GaiaApp) initController(ctx sdk.Context, bootstrap bool) {
 	if err != nil {
 		panic(err)
 	}
+	if swingsetConfig != nil && swingsetConfig.TracePortCalls {
+		app.AgdServer.SetTracer(otel.Tracer("agd/vm"))
+	}
 	action := &cosmosInitAction{
 		ChainID:        ctx.ChainID(),
 		IsBootstrap:    bootstrap,
@@ -1086,7 +1113,9 @@ func (app *GaiaApp) initController(ctx sdk.Context, bootstrap bool) {
 		ResolvedConfig: swingsetConfig,
 		SupplyCoins:    sdk.NewCoins(app.BankKeeper.GetSupply(ctx, "uist")),
 		UpgradeDetails: app.upgradeDetails,
+		PortEncodings:  app.AgdServer.PortEncodings(),
 		// See CAVEAT in cosmosInitAction.
+		AgdServerPort:   vm.AgdServerPort,
 		StoragePort:     app.vstoragePort,
 		SwingsetPort:    app.swingsetPort,
 		VbankPort:       app.vbankPort,
@@ -1139,7 +1168,19 @@ func (app *GaiaApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 
 // EndBlocker application updates every end block
 func (app *GaiaApp) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
-	return app.ModuleManager.EndBlock(ctx)
+	res, err := app.ModuleManager.EndBlock(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	// vbank's block summaries must include the SwingSet VM's activity, so they
+	// follow every module's EndBlock.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	if err := vbank.EndBlockAfterVM(ctx, app.VbankKeeper); err != nil {
+		return res, err
+	}
+	res.Events = append(res.Events, ctx.EventManager().ABCIEvents()...)
+	return res, nil
 }
 
 // InitChainer application update at chain initialization
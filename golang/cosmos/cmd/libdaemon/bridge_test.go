@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+type bytesMessage struct {
+	Type string `json:"type"`
+	Data []byte `json:"data"`
+}
+
+// cborEchoHandler replies with the message it receives, in its encoding.
+type cborEchoHandler struct{}
+
+func (cborEchoHandler) Receive(ctx context.Context, str string) (string, error) {
+	var msg bytesMessage
+	enc, err := vm.UnmarshalData(str, &msg)
+	if err != nil {
+		return "", err
+	}
+	return vm.MarshalData(enc, msg)
+}
+
+func (cborEchoHandler) Encodings() []vm.Encoding {
+	return []vm.Encoding{vm.EncodingCBOR}
+}
+
+func TestSendToGoCarriesCBORWithNUL(t *testing.T) {
+	agdServer = vm.NewAgdServer()
+	port := agdServer.MustRegisterPortHandler("echo", cborEchoHandler{})
+
+	sent := bytesMessage{Type: "ECHO", Data: []byte{0, 1, 0}}
+	data, err := vm.MarshalData(vm.EncodingCBOR, sent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(raw, []byte{0}) {
+		t.Fatalf("CBOR %x should contain a NUL", raw)
+	}
+
+	// Cross the C boundary both ways, as SendToGo does.
+	reply := bodyString(newBody(sendToGo(port, bodyString(newBody(data)))))
+	if enc := vm.DetectEncoding(reply); enc != vm.EncodingCBOR {
+		t.Fatalf("reply %q has encoding %q, want %q", reply, enc, vm.EncodingCBOR)
+	}
+	var got bytesMessage
+	if _, err := vm.UnmarshalData(reply, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != sent.Type || !bytes.Equal(got.Data, sent.Data) {
+		t.Errorf("round trip = %+v, want %+v", got, sent)
+	}
+}
+
+func TestSendToGoRejectsCallsOverBudget(t *testing.T) {
+	agdServer = vm.NewAgdServer()
+	port := agdServer.MustRegisterPortHandler("echo", cborEchoHandler{})
+	agdServer.SetPortCallBudgets(func(ctx sdk.Context, portName string) (uint64, bool) {
+		return 1, true
+	})
+	ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(3)
+	defer agdServer.SetControllerContext(ctx)()
+
+	msg := `{"type":"ECHO","data":null}`
+	if reply := sendToGo(port, msg); reply != msg {
+		t.Fatalf("first reply = %q, want %q", reply, msg)
+	}
+	want := `{"error":"port call budget exceeded"}`
+	if reply := sendToGo(port, msg); reply != want {
+		t.Errorf("over budget reply = %q, want %q", reply, want)
+	}
+}
@@ -104,7 +104,7 @@ func RunAgCosmosDaemon(nodePort C.int, toNode C.sendFunc, cosmosArgs []*C.char)
 	var sendToNode vm.Sender
 
 	sendFunc := func(port int, reply int, str string) {
-		C.invokeSendFunc(toNode, C.int(port), C.int(reply), C.CString(str))
+		C.invokeSendFunc(toNode, C.int(port), C.int(reply), newBody(str))
 	}
 
 	vmClientCodec, sendToNode = ConnectVMClientCodec(
@@ -144,7 +144,7 @@ func RunAgCosmosDaemon(nodePort C.int, toNode C.sendFunc, cosmosArgs []*C.char)
 //export ReplyToGo
 func ReplyToGo(replyPort C.int, isError C.int, resp C.Body) C.int {
 	defer handlePanic("ReplyToGo")
-	respStr := C.GoString(resp)
+	respStr := bodyString(resp)
 	// fmt.Printf("Reply to Go %d %s\n", replyPort, respStr)
 	if err := vmClientCodec.Receive(int(replyPort), int(isError) != 0, respStr); err != nil {
 		return C.int(1)
@@ -156,21 +156,38 @@ type errorWrapper struct {
 	Error string `json:"error"`
 }
 
+// newBody copies str into a C Body, to be freed by its receiver.  A Body is
+// NUL-terminated, so str must not contain NUL: vm.MarshalData ensures this by
+// encoding CBOR as base64.
+func newBody(str string) C.Body {
+	return C.CString(str)
+}
+
+// bodyString copies a C Body into a Go string.
+func bodyString(body C.Body) string {
+	return C.GoString(body)
+}
+
 //export SendToGo
 func SendToGo(port C.int, msg C.Body) C.Body {
 	defer handlePanic("SendToGo")
-	msgStr := C.GoString(msg)
+	return newBody(sendToGo(int(port), bodyString(msg)))
+}
+
+// sendToGo delivers a message from the VM to port, returning the reply or a
+// JSON error.
+func sendToGo(port int, msgStr string) string {
 	// fmt.Fprintln(os.Stderr, "Send to Go", msgStr)
 	var respStr string
 	message := &vm.Message{
-		Port:       int(port),
+		Port:       port,
 		NeedsReply: true,
 		Data:       msgStr,
 	}
 
 	err := agdServer.ReceiveMessage(message, &respStr)
 	if err == nil {
-		return C.CString(respStr)
+		return respStr
 	}
 
 	// fmt.Fprintln(os.Stderr, "Cannot receive from controller", err)
@@ -181,7 +198,7 @@ func SendToGo(port C.int, msg C.Body) C.Body {
 	if err != nil {
 		panic(err)
 	}
-	return C.CString(string(respBytes))
+	return string(respBytes)
 }
 
 // Do nothing in main.
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+const (
+	FlagSchemaFormat = "format"
+
+	schemaFormatJSON       = "json"
+	schemaFormatTypeScript = "ts"
+)
+
+// ActionSchemaCommand returns a command that prints a description of every
+// action and port message registered with the vm package, for use by the JS
+// side of the bridge.
+func ActionSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "action-schema",
+		Short: "Print the schema of all actions and port messages exchanged with the VM",
+		Long: `Print the schema of all actions sent to the VM and all messages accepted
+by its bridge ports, either as a JSON Schema document or as TypeScript
+type definitions.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString(FlagSchemaFormat)
+			if err != nil {
+				return err
+			}
+			switch format {
+			case schemaFormatJSON:
+				bz, err := json.MarshalIndent(vm.ActionJSONSchema(), "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			case schemaFormatTypeScript:
+				fmt.Fprint(cmd.OutOrStdout(), vm.ActionTypeScript())
+			default:
+				return fmt.Errorf("unknown format %q; expected %q or %q", format, schemaFormatJSON, schemaFormatTypeScript)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagSchemaFormat, schemaFormatJSON, fmt.Sprintf("output format (%q or %q)", schemaFormatJSON, schemaFormatTypeScript))
+	return cmd
+}
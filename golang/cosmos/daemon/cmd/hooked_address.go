@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	agtypes "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+)
+
+// HookedAddressCommand returns a command to encode and decode Address Hooks,
+// to be added to the debug commands.
+func HookedAddressCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hooked-address",
+		Short: "Encode and decode bech32 addresses with hook queries",
+	}
+	cmd.AddCommand(
+		hookedAddressEncodeCommand(),
+		hookedAddressDecodeCommand(),
+	)
+	return cmd
+}
+
+func hookedAddressEncodeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "encode [base-address] [query]",
+		Short: "Encode a base address and an HTTP query string as a hooked address",
+		Long: `Encode a base address and an HTTP query string as a hooked address, after
+validating the keys of the query that the hook payload defines: v, dest, memo,
+fwdChannel and fwdReceiver.`,
+		Example: fmt.Sprintf(`$ %s debug hooked-address encode agoric1qqp0e5ys 'key=value&foo=bar&foo=baz'`,
+			AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := url.Values{}
+			if len(args) > 1 {
+				var err error
+				query, err = url.ParseQuery(strings.TrimPrefix(args[1], agtypes.AddressHookQueryPrefix))
+				if err != nil {
+					return fmt.Errorf("invalid query: %w", err)
+				}
+			}
+			if _, err := agtypes.ParseAddressHookPayload(query); err != nil {
+				return err
+			}
+			addr, err := agtypes.EncodeAddressHook(args[0], query)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), addr)
+			return nil
+		},
+	}
+}
+
+func hookedAddressDecodeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode [address]",
+		Short: "Decode a hooked address into its base address, hook query and payload",
+		Long: `Decode a hooked address into its base address, hook query and the typed
+payload of the query, printed as JSON.  An address without a hook decodes to
+itself and an empty query.`,
+		Example: fmt.Sprintf(`$ %s debug hooked-address decode agoric10rchqqplvehk70tzv9ezven0du7kyct6ye4k27faweskcat9qqqstnf2eq`,
+			AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseAddr, query, err := agtypes.DecodeAddressHook(args[0])
+			if err != nil {
+				return err
+			}
+			payload, err := agtypes.ParseAddressHookPayload(query)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(struct {
+				BaseAddress string                     `json:"baseAddress"`
+				Query       url.Values                 `json:"query"`
+				Payload     agtypes.AddressHookPayload `json:"payload"`
+			}{baseAddr, query, payload}, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return nil
+		},
+	}
+}
@@ -233,13 +233,16 @@ func initRootCmd(sender vm.Sender, rootCmd *cobra.Command, encodingConfig params
 	rootCmd.AddCommand(
 		genesisCmd.Commands()...,
 	)
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(HookedAddressCommand())
 	rootCmd.AddCommand(
 		tmcli.NewCompletionCmd(rootCmd, true),
 		simdcmd.NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}, AppName),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(ac.newSnapshotsApp, gaia.DefaultNodeHome),
 		snapshot.Cmd(ac.newSnapshotsApp),
+		ActionSchemaCommand(),
 	)
 
 	server.AddCommandsWithStartCmdOptions(rootCmd, gaia.DefaultNodeHome, ac.newApp, ac.appExport, server.StartCmdOptions{
@@ -196,3 +196,25 @@ func TestCLIFlags(t *testing.T) {
 		)
 	}
 }
+
+func TestHookedAddressCmd(t *testing.T) {
+	hookedAddress := "agoric10rchqqplvehk70tzv9ezven0du7kyct6ye4k27faweskcat9qqqstnf2eq"
+
+	var out bytes.Buffer
+	encodeCmd := cmd.HookedAddressCommand()
+	encodeCmd.SetOut(&out)
+	encodeCmd.SetArgs([]string{"encode", "agoric1qqp0e5ys", "?key=value&foo=bar&foo=baz"})
+	require.NoError(t, encodeCmd.Execute())
+	require.Equal(t, hookedAddress+"\n", out.String())
+
+	out.Reset()
+	decodeCmd := cmd.HookedAddressCommand()
+	decodeCmd.SetOut(&out)
+	decodeCmd.SetArgs([]string{"decode", hookedAddress})
+	require.NoError(t, decodeCmd.Execute())
+	require.JSONEq(t, `{"baseAddress":"agoric1qqp0e5ys","query":{"foo":["bar","baz"],"key":["value"]},"payload":{"version":1,"extra":{"foo":["bar","baz"],"key":["value"]}}}`, out.String())
+
+	encodeCmd = cmd.HookedAddressCommand()
+	encodeCmd.SetArgs([]string{"encode", "agoric1qqp0e5ys", "fwdChannel=bad"})
+	require.ErrorContains(t, encodeCmd.Execute(), "forward channel")
+}
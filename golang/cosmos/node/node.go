@@ -0,0 +1,299 @@
+// Package node runs an Agoric chain node in-process, for Go programs (such as
+// simulators and indexers) that supply their own VM controller instead of
+// the Node.js one used by ag-chain-cosmos.
+//
+// Unlike the daemon package, it neither parses command-line arguments nor
+// exits the process: configuration is read from the node's home directory,
+// optionally overridden by Options, and the node runs until its context is
+// done or Stop is called.
+//
+//	n, err := node.Start(ctx, node.Options{
+//		Home:       home,
+//		Controller: myController,
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer n.Stop()
+//	port := n.AgdServer().GetPort("vstorage")
+//
+// The home directory must already have been initialized (for example by
+// `agd init`) with a genesis file and node key.
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtnode "github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	pvm "github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/proxy"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/viper"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/agoric"
+	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// ShutdownRequest is sent (without expecting a reply) to the controller when
+// the node stops, as ag-chain-cosmos does on SIGINT/SIGTERM.
+const ShutdownRequest = "shutdown"
+
+// Options configures a node started by Start.
+type Options struct {
+	// Home is the node's home directory, which contains config/ and data/.
+	Home string
+	// Controller receives the requests that the node makes of the VM.  It is
+	// required.
+	Controller vm.Sender
+	// AgdServer dispatches calls from the VM to the node's bridge ports.  If
+	// nil, a new one is created.  Either way it is available from
+	// Node.AgdServer once the node has started.
+	AgdServer *vm.AgdServer
+	// Logger defaults to logging to standard error.
+	Logger log.Logger
+	// AppOptions overrides settings of Home/config/app.toml, keyed as they
+	// would be there (e.g., "pruning" or "swingset.slogfile").
+	AppOptions map[string]interface{}
+	// CometConfig, if non-nil, is used in place of Home/config/config.toml.
+	// Its RootDir defaults to Home.
+	CometConfig *cmtcfg.Config
+}
+
+// Node is a running Agoric chain node.
+type Node struct {
+	app       *gaia.GaiaApp
+	agdServer *vm.AgdServer
+	comet     *cmtnode.Node
+	logger    log.Logger
+	sender    vm.Sender
+
+	stopOnce sync.Once
+	stopErr  error
+	done     chan struct{}
+}
+
+var sdkConfigOnce sync.Once
+
+// configureSDK sets the Agoric address prefixes on the global sdk.Config,
+// unless the embedding program has already done so.
+func configureSDK() {
+	sdkConfigOnce.Do(func() {
+		config := sdk.GetConfig()
+		if config.GetBech32AccountAddrPrefix() == agoric.Bech32PrefixAccAddr {
+			return
+		}
+		agoric.SetAgoricConfig(config)
+		config.Seal()
+	})
+}
+
+// Start starts a node as configured by opts.  The node stops when ctx is done
+// or Stop is called, whichever happens first.
+func Start(ctx context.Context, opts Options) (*Node, error) {
+	if opts.Controller == nil {
+		return nil, errors.New("node: Options.Controller is required")
+	}
+	if opts.Home == "" {
+		return nil, errors.New("node: Options.Home is required")
+	}
+	home, err := filepath.Abs(opts.Home)
+	if err != nil {
+		return nil, err
+	}
+	logger := opts.Logger
+	if logger == nil {
+		logger = log.NewLogger(os.Stderr)
+	}
+	agdServer := opts.AgdServer
+	if agdServer == nil {
+		agdServer = vm.NewAgdServer()
+	}
+
+	configureSDK()
+
+	appOpts, err := loadAppOptions(home, opts.AppOptions)
+	if err != nil {
+		return nil, err
+	}
+	cometCfg := opts.CometConfig
+	if cometCfg == nil {
+		if cometCfg, err = loadCometConfig(home); err != nil {
+			return nil, err
+		}
+	} else if cometCfg.RootDir == "" {
+		cometCfg.SetRoot(home)
+	}
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(appOpts), filepath.Join(home, "data"))
+	if err != nil {
+		return nil, err
+	}
+	app := gaia.NewAgoricApp(
+		opts.Controller, agdServer,
+		logger, db, nil, true,
+		appOpts,
+		server.DefaultBaseappOptions(appOpts)...,
+	)
+
+	n := &Node{
+		app:       app,
+		agdServer: agdServer,
+		logger:    logger,
+		sender:    opts.Controller,
+		done:      make(chan struct{}),
+	}
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(cometCfg.NodeKeyFile())
+	if err != nil {
+		return nil, errors.Join(err, app.Close())
+	}
+	n.comet, err = cmtnode.NewNodeWithContext(
+		ctx,
+		cometCfg,
+		pvm.LoadOrGenFilePV(cometCfg.PrivValidatorKeyFile(), cometCfg.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(server.NewCometABCIWrapper(app)),
+		genesisDocProvider(cometCfg),
+		cmtcfg.DefaultDBProvider,
+		cmtnode.DefaultMetricsProvider(cometCfg.Instrumentation),
+		servercmtlog.CometLoggerWrapper{Logger: logger},
+	)
+	if err != nil {
+		return nil, errors.Join(err, app.Close())
+	}
+	if err := n.comet.Start(); err != nil {
+		return nil, errors.Join(err, app.Close())
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			if err := n.Stop(); err != nil {
+				logger.Error("failed to stop node", "err", err)
+			}
+		case <-n.done:
+		}
+	}()
+	return n, nil
+}
+
+// Stop gracefully stops the node: CometBFT stops producing blocks, the
+// controller is sent a ShutdownRequest, and the application databases are
+// closed.  It is safe to call more than once, and returns the same result
+// each time.
+func (n *Node) Stop() error {
+	n.stopOnce.Do(func() {
+		var errs []error
+		if n.comet.IsRunning() {
+			if err := n.comet.Stop(); err != nil {
+				errs = append(errs, err)
+			}
+			n.comet.Wait()
+		}
+		if _, err := n.sender(context.Background(), false, ShutdownRequest); err != nil {
+			errs = append(errs, fmt.Errorf("controller shutdown: %w", err))
+		}
+		if err := n.app.Close(); err != nil {
+			errs = append(errs, err)
+		}
+		n.stopErr = errors.Join(errs...)
+		close(n.done)
+	})
+	return n.stopErr
+}
+
+// Done returns a channel that is closed once the node has stopped.
+func (n *Node) Done() <-chan struct{} {
+	return n.done
+}
+
+// App returns the node's ABCI application.
+func (n *Node) App() *gaia.GaiaApp {
+	return n.app
+}
+
+// AgdServer returns the server that dispatches calls from the VM to the
+// node's bridge ports.
+func (n *Node) AgdServer() *vm.AgdServer {
+	return n.agdServer
+}
+
+// Comet returns the node's CometBFT node, e.g. for access to its RPC
+// environment or event bus.
+func (n *Node) Comet() *cmtnode.Node {
+	return n.comet
+}
+
+// loadAppOptions reads home/config/app.toml (if present) and applies the
+// overrides.
+func loadAppOptions(home string, overrides map[string]interface{}) (*viper.Viper, error) {
+	v := viper.New()
+	appConfigFile := filepath.Join(home, "config", "app.toml")
+	if _, err := os.Stat(appConfigFile); err == nil {
+		v.SetConfigFile(appConfigFile)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", appConfigFile, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	v.Set(flags.FlagHome, home)
+	// Without app.toml, prune as its default would.
+	v.SetDefault(server.FlagPruning, pruningtypes.PruningOptionDefault)
+	// Default the swing-store export directory as the agd start command does.
+	if v.GetString(gaia.FlagSwingStoreExportDir) == "" {
+		v.Set(gaia.FlagSwingStoreExportDir, filepath.Join(home, "config", "swing-store"))
+	}
+	for key, val := range overrides {
+		v.Set(key, val)
+	}
+	return v, nil
+}
+
+// loadCometConfig reads home/config/config.toml (if present) over the
+// CometBFT defaults.
+func loadCometConfig(home string) (*cmtcfg.Config, error) {
+	cfg := cmtcfg.DefaultConfig()
+	cometConfigFile := filepath.Join(home, "config", "config.toml")
+	if _, err := os.Stat(cometConfigFile); err == nil {
+		v := viper.New()
+		v.SetConfigFile(cometConfigFile)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", cometConfigFile, err)
+		}
+		if err := v.Unmarshal(cfg); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	cfg.SetRoot(home)
+	return cfg, cfg.ValidateBasic()
+}
+
+// genesisDocProvider reads the genesis file of cfg.
+func genesisDocProvider(cfg *cmtcfg.Config) cmtnode.GenesisDocProvider {
+	return func() (*cmttypes.GenesisDoc, error) {
+		appGenesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
+		if err != nil {
+			return nil, err
+		}
+		return appGenesis.ToGenesisDoc()
+	}
+}
@@ -0,0 +1,279 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	pvm "github.com/cometbft/cometbft/privval"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	gaia "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// recordingController replies truthily to every request, as the VM must for
+// the node to get past AG_COSMOS_INIT, and records the requests it receives.
+type recordingController struct {
+	mtx      sync.Mutex
+	requests []string
+}
+
+func (c *recordingController) send(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.requests = append(c.requests, jsonRequest)
+	return "true", nil
+}
+
+func (c *recordingController) lastRequest() string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if len(c.requests) == 0 {
+		return ""
+	}
+	return c.requests[len(c.requests)-1]
+}
+
+// initTestHome initializes home as a single-validator network whose validator
+// is the node itself, returning its CometBFT configuration.
+func initTestHome(t *testing.T, home string) *cmtcfg.Config {
+	t.Helper()
+	configureSDK()
+
+	cfg := cmtcfg.TestConfig()
+	cfg.SetRoot(home)
+	cmtcfg.EnsureRoot(home)
+	cfg.P2P.ListenAddress = "tcp://127.0.0.1:0"
+	cfg.RPC.ListenAddress = ""
+	cfg.Consensus.TimeoutCommit = 10 * time.Millisecond
+
+	pv := pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	app := gaia.NewAgoricApp(
+		(&recordingController{}).send, vm.NewAgdServer(),
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{},
+	)
+	acc := authtypes.NewBaseAccount(sdk.AccAddress(pubKey.Address()), nil, 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+	}
+	genesisState, err := sims.GenesisStateWithValSet(
+		app.AppCodec(), app.DefaultGenesis(), valSet,
+		[]authtypes.GenesisAccount{acc}, balance,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The validator must have signing info for its signatures to be counted.
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	genesisState[slashingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(slashingtypes.NewGenesisState(
+		slashingtypes.DefaultParams(),
+		[]slashingtypes.SigningInfo{{
+			Address:              consAddr.String(),
+			ValidatorSigningInfo: slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0),
+		}},
+		nil,
+	))
+	appState, err := json.Marshal(genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := genutiltypes.NewAppGenesisWithVersion("agoric-node-test", appState).SaveAs(cfg.GenesisFile()); err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func TestStartRequiresOptions(t *testing.T) {
+	controller := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+		return "", nil
+	}
+	testCases := []struct {
+		name    string
+		opts    Options
+		wantErr string
+	}{
+		{"no controller", Options{Home: t.TempDir()}, "Options.Controller is required"},
+		{"no home", Options{Controller: controller}, "Options.Home is required"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			n, err := Start(context.Background(), tc.opts)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("Start() = %v, %v; want error %q", n, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadAppOptions(t *testing.T) {
+	home := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(home, "config"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	appToml := "pruning = \"nothing\"\nhalt-height = 7\n\n[swingset]\nslogfile = \"from-file\"\n"
+	if err := os.WriteFile(filepath.Join(home, "config", "app.toml"), []byte(appToml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := loadAppOptions(home, map[string]interface{}{"halt-height": 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := v.GetString("pruning"); got != "nothing" {
+		t.Errorf("pruning = %q, want %q", got, "nothing")
+	}
+	if got := v.GetInt("halt-height"); got != 9 {
+		t.Errorf("halt-height = %d, want the override 9", got)
+	}
+	if got := v.GetString("swingset.slogfile"); got != "from-file" {
+		t.Errorf("swingset.slogfile = %q, want %q", got, "from-file")
+	}
+	if got := v.GetString(flags.FlagHome); got != home {
+		t.Errorf("home = %q, want %q", got, home)
+	}
+	wantExportDir := filepath.Join(home, "config", "swing-store")
+	if got := v.GetString(gaia.FlagSwingStoreExportDir); got != wantExportDir {
+		t.Errorf("swing-store export dir = %q, want %q", got, wantExportDir)
+	}
+
+	// Without app.toml, the pruning strategy is the default rather than empty.
+	if v, err = loadAppOptions(t.TempDir(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := v.GetString("pruning"); got != "default" {
+		t.Errorf("pruning without app.toml = %q, want %q", got, "default")
+	}
+}
+
+func TestLoadCometConfig(t *testing.T) {
+	home := t.TempDir()
+	cfg, err := loadCometConfig(home)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.RootDir != home {
+		t.Errorf("RootDir = %q, want %q", cfg.RootDir, home)
+	}
+	if want := filepath.Join(home, "config", "genesis.json"); cfg.GenesisFile() != want {
+		t.Errorf("GenesisFile() = %q, want %q", cfg.GenesisFile(), want)
+	}
+
+	if err := os.MkdirAll(filepath.Join(home, "config"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	configToml := "moniker = \"embedded\"\n\n[consensus]\ntimeout_commit = \"2s\"\n"
+	if err := os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte(configToml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = loadCometConfig(home)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Moniker != "embedded" {
+		t.Errorf("Moniker = %q, want %q", cfg.Moniker, "embedded")
+	}
+	if got := cfg.Consensus.TimeoutCommit.String(); got != "2s" {
+		t.Errorf("TimeoutCommit = %s, want 2s", got)
+	}
+}
+
+func TestStartStop(t *testing.T) {
+	home := t.TempDir()
+	cfg := initTestHome(t, home)
+	controller := &recordingController{}
+
+	n, err := Start(context.Background(), Options{
+		Home:        home,
+		Controller:  controller.send,
+		Logger:      log.NewNopLogger(),
+		CometConfig: cfg,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer n.Stop()
+
+	if got := n.AgdServer().GetPort(vm.AgdServerPortName); got != vm.AgdServerPort {
+		t.Errorf("GetPort(%q) = %d, want %d", vm.AgdServerPortName, got, vm.AgdServerPort)
+	}
+
+	// Wait for the node to commit some blocks.
+	deadline := time.Now().Add(30 * time.Second)
+	for n.App().LastBlockHeight() < 3 {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out at height %d", n.App().LastBlockHeight())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := n.Stop(); err != nil {
+		t.Fatalf("Stop() = %v", err)
+	}
+	select {
+	case <-n.Done():
+	default:
+		t.Fatal("Done() not closed after Stop()")
+	}
+	if n.Comet().IsRunning() {
+		t.Error("CometBFT node still running after Stop()")
+	}
+	if got := controller.lastRequest(); got != ShutdownRequest {
+		t.Errorf("last controller request = %q, want %q", got, ShutdownRequest)
+	}
+	if err := n.Stop(); err != nil {
+		t.Errorf("second Stop() = %v", err)
+	}
+}
+
+func TestStopOnContextDone(t *testing.T) {
+	home := t.TempDir()
+	cfg := initTestHome(t, home)
+	controller := &recordingController{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n, err := Start(ctx, Options{
+		Home:        home,
+		Controller:  controller.send,
+		Logger:      log.NewNopLogger(),
+		CometConfig: cfg,
+	})
+	if err != nil {
+		cancel()
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case <-n.Done():
+	case <-time.After(30 * time.Second):
+		n.Stop()
+		t.Fatal("node did not stop when its context was done")
+	}
+	if got := controller.lastRequest(); got != ShutdownRequest {
+		t.Errorf("last controller request = %q, want %q", got, ShutdownRequest)
+	}
+}
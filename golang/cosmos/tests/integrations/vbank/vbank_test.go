@@ -30,6 +30,7 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank"
 	vbanktypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
@@ -63,7 +64,8 @@ func (f *VbankFixtures) advanceBlock(t *testing.T) {
 	f.ctx = f.ctx.WithBlockHeight(currentHeight + 1).WithBlockTime(currentTime.Add(5 * time.Second))
 
 	err := f.vbankModule.EndBlock(f.ctx)
-
+	require.NoError(t, err)
+	err = vbank.EndBlockAfterVM(f.ctx, f.vbankKeeper)
 	require.NoError(t, err)
 
 }
@@ -108,10 +110,11 @@ func initVbankFixtures(t *testing.T) VbankFixtures {
 
 	maccPerms := map[string][]string{
 		minttypes.ModuleName:         {authtypes.Minter},
-		vbank.ModuleName:             {authtypes.Burner, authtypes.Staking},
+		vbank.ModuleName:             {authtypes.Minter, authtypes.Burner, authtypes.Staking},
 		vbanktypes.ReservePoolName:   nil,
 		vbanktypes.ProvisionPoolName: nil,
 		vbanktypes.GiveawayPoolName:  nil,
+		vbanktypes.HoldPoolName:      nil,
 	}
 
 	// Initialize all keepers
@@ -171,7 +174,7 @@ func initVbankFixtures(t *testing.T) VbankFixtures {
 		bankKeeper,
 		authtypes.FeeCollectorName,
 		swingSetKeeper.PushAction,
-	)
+	).WithAuthority(authority.String())
 
 	// Create app modules
 	authModule := auth.NewAppModule(
@@ -205,7 +208,7 @@ func initVbankFixtures(t *testing.T) VbankFixtures {
 	sdkCtx := sdk.UnwrapSDKContext(integrationApp.Context())
 
 	// Register message and query servers
-	vbanktypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), vbanktypes.UnimplementedMsgServer{})
+	vbanktypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), vbank.NewMsgServerImpl(vbankKeeper))
 	vbanktypes.RegisterQueryServer(integrationApp.QueryHelper(), &vbanktypes.UnimplementedQueryServer{})
 
 	return VbankFixtures{
@@ -345,5 +348,277 @@ func Test_EndBlock_Events(t *testing.T) {
 
 		postCount++
 	}
+	require.Equal(t, len(expected), postCount)
+
+}
+
+func Test_Holds(t *testing.T) {
+	t.Parallel()
+	f := initVbankFixtures(t)
+	f.ctx = f.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	owner := sdk.AccAddress(priv3.PubKey().Address())
+	holdPool := authtypes.NewModuleAddress(vbanktypes.HoldPoolName)
+	initial := sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000))
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, "mint", initial))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.ctx, "mint", owner, initial))
+
+	hold := func(id string, amount, expiry int64) vbanktypes.Hold {
+		return vbanktypes.Hold{
+			Id:         id,
+			Owner:      owner.String(),
+			Coins:      sdk.NewCoins(sdk.NewInt64Coin("ubld", amount)),
+			ExpiryTime: expiry,
+		}
+	}
+	require.NoError(t, f.vbankKeeper.CreateHold(f.ctx, hold("a", 100, 0)))
+	require.NoError(t, f.vbankKeeper.CreateHold(f.ctx, hold("b", 200, 1003)))
+	require.NoError(t, f.vbankKeeper.CreateHold(f.ctx, hold("c", 300, 1007)))
+	require.ErrorContains(t, f.vbankKeeper.CreateHold(f.ctx, hold("a", 1, 0)), "already exists")
+	require.Error(t, f.vbankKeeper.CreateHold(f.ctx, hold("d", 1000, 0)))
+
+	require.Equal(t, int64(400), f.bankKeeper.GetBalance(f.ctx, owner, "ubld").Amount.Int64())
+	require.Equal(t, int64(600), f.bankKeeper.GetBalance(f.ctx, holdPool, "ubld").Amount.Int64())
+
+	res, err := f.vbankKeeper.Holds(f.ctx, &vbanktypes.QueryHoldsRequest{Address: owner.String()})
+	require.NoError(t, err)
+	require.Equal(t, []vbanktypes.Hold{hold("a", 100, 0), hold("b", 200, 1003), hold("c", 300, 1007)}, res.Holds)
+
+	// Capturing burns the held coins.
+	_, err = f.vbankKeeper.CaptureHold(f.ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, int64(400), f.bankKeeper.GetBalance(f.ctx, owner, "ubld").Amount.Int64())
+	require.Equal(t, int64(900), f.bankKeeper.GetSupply(f.ctx, "ubld").Amount.Int64())
+	_, err = f.vbankKeeper.ReleaseHold(f.ctx, "a")
+	require.ErrorContains(t, err, "not found")
+
+	// The next block (at time 1005) releases only the expired hold.
+	f.advanceBlock(t)
+	require.Equal(t, int64(600), f.bankKeeper.GetBalance(f.ctx, owner, "ubld").Amount.Int64())
+	_, found, err := f.vbankKeeper.GetHold(f.ctx, "b")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// Releasing returns the held coins.
+	_, err = f.vbankKeeper.ReleaseHold(f.ctx, "c")
+	require.NoError(t, err)
+	require.Equal(t, int64(900), f.bankKeeper.GetBalance(f.ctx, owner, "ubld").Amount.Int64())
+	require.True(t, f.bankKeeper.GetBalance(f.ctx, holdPool, "ubld").IsZero())
+	holds, err := f.vbankKeeper.GetAllHolds(f.ctx)
+	require.NoError(t, err)
+	require.Empty(t, holds)
+}
+
+func Test_Watches(t *testing.T) {
+	t.Parallel()
+	f := initVbankFixtures(t)
+	f.vbankKeeper.SetParams(f.ctx, vbanktypes.DefaultParams())
+
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+	addr3 := sdk.AccAddress(priv3.PubKey().Address())
+
+	_, err := f.vbankKeeper.AddWatch(f.ctx, addr1.String(), "ubld")
+	require.NoError(t, err)
+	_, err = f.vbankKeeper.AddWatch(f.ctx, addr2.String(), vbanktypes.WatchAllDenoms)
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ubld", 100), sdk.NewInt64Coin("urun", 200))
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, "mint", coins))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.ctx, "mint", addr1, coins))
+	require.NoError(t, f.bankKeeper.SendCoins(f.ctx, addr1, addr2, coins))
+	require.NoError(t, f.bankKeeper.SendCoins(f.ctx, addr2, addr3, coins))
+
+	// Only the watched addresses and denoms are reported (with the amounts
+	// only accumulated to track the denoms).
+	got, err := f.vbankKeeper.WatchedBalanceUpdates(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Coins{
+		addr1.String(): sdk.NewCoins(sdk.NewInt64Coin("ubld", 200)),
+		addr2.String(): sdk.NewCoins(sdk.NewInt64Coin("ubld", 200), sdk.NewInt64Coin("urun", 400)),
+	}, got)
+
+	res, err := f.vbankKeeper.Watches(f.ctx, &vbanktypes.QueryWatchesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Watches, 2)
+	res, err = f.vbankKeeper.Watches(f.ctx, &vbanktypes.QueryWatchesRequest{Address: addr2.String()})
+	require.NoError(t, err)
+	require.Equal(t, []vbanktypes.Watch{{Address: addr2.String(), Denom: vbanktypes.WatchAllDenoms, Count: 1}}, res.Watches)
+}
+
+func Test_PoolFlows(t *testing.T) {
+	t.Parallel()
+	f := initVbankFixtures(t)
+	f.vbankKeeper.SetParams(f.ctx, vbanktypes.DefaultParams())
+
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ubld", 100))
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, "mint", coins))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.ctx, "mint", addr1, coins))
+
+	ubld := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("ubld", amt)) }
+	feeCtx := agoric.WithPoolFlowCategory(f.ctx, agoric.PoolFlowCategoryAdmissionFee)
+	require.NoError(t, f.bankKeeper.SendCoinsFromAccountToModule(feeCtx, addr1, vbanktypes.ReservePoolName, ubld(10)))
+	require.NoError(t, f.bankKeeper.SendCoinsFromAccountToModule(feeCtx, addr1, vbanktypes.ReservePoolName, ubld(5)))
+	require.NoError(t, f.bankKeeper.SendCoinsFromAccountToModule(f.ctx, addr1, vbanktypes.ProvisionPoolName, ubld(7)))
+
+	// A flow made by the VM during SwingSet's EndBlock, after vbank's, is still
+	// included in the block's events.
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.vbankModule.EndBlock(f.ctx))
+	transferCtx := agoric.WithPoolFlowCategory(f.ctx, agoric.PoolFlowCategoryVbankTransfer)
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToModule(transferCtx, vbanktypes.ReservePoolName, vbanktypes.ProvisionPoolName, ubld(3)))
+	require.NoError(t, vbank.EndBlockAfterVM(f.ctx, f.vbankKeeper))
+
+	provisionFlows := []vbanktypes.PoolFlow{
+		{Pool: vbanktypes.ProvisionPoolName, Direction: vbanktypes.PoolFlowIn, Category: agoric.PoolFlowCategoryOther, Amount: ubld(7)},
+		{Pool: vbanktypes.ProvisionPoolName, Direction: vbanktypes.PoolFlowIn, Category: agoric.PoolFlowCategoryVbankTransfer, Amount: ubld(3)},
+	}
+	reserveFlows := []vbanktypes.PoolFlow{
+		{Pool: vbanktypes.ReservePoolName, Direction: vbanktypes.PoolFlowIn, Category: agoric.PoolFlowCategoryAdmissionFee, Amount: ubld(15)},
+		{Pool: vbanktypes.ReservePoolName, Direction: vbanktypes.PoolFlowOut, Category: agoric.PoolFlowCategoryVbankTransfer, Amount: ubld(3)},
+	}
+	allFlows := append(append([]vbanktypes.PoolFlow{}, provisionFlows...), reserveFlows...)
+
+	var events []vbanktypes.PoolFlow
+	for _, event := range f.ctx.EventManager().Events() {
+		if event.Type != vbanktypes.EventTypePoolFlow {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		amount, err := sdk.ParseCoinsNormalized(attrs[sdk.AttributeKeyAmount])
+		require.NoError(t, err)
+		events = append(events, vbanktypes.PoolFlow{
+			Pool:      attrs[vbanktypes.AttributeKeyPool],
+			Direction: attrs[vbanktypes.AttributeKeyDirection],
+			Category:  attrs[vbanktypes.AttributeKeyCategory],
+			Amount:    amount,
+		})
+	}
+	require.Equal(t, allFlows, events)
+
+	res, err := f.vbankKeeper.PoolFlows(f.ctx, &vbanktypes.QueryPoolFlowsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, allFlows, res.Flows)
+	res, err = f.vbankKeeper.PoolFlows(f.ctx, &vbanktypes.QueryPoolFlowsRequest{Pool: vbanktypes.ReservePoolName})
+	require.NoError(t, err)
+	require.Equal(t, reserveFlows, res.Flows)
+	_, err = f.vbankKeeper.PoolFlows(f.ctx, &vbanktypes.QueryPoolFlowsRequest{Pool: vbanktypes.HoldPoolName})
+	require.Error(t, err)
+
+	gs, err := vbank.ExportGenesis(f.ctx, f.vbankKeeper)
+	require.NoError(t, err)
+	require.Equal(t, allFlows, gs.PoolFlows)
+	require.NoError(t, vbank.ValidateGenesis(gs))
+}
+
+func Test_OutflowLimits(t *testing.T) {
+	t.Parallel()
+	f := initVbankFixtures(t)
+	f.vbankKeeper.SetParams(f.ctx, vbanktypes.DefaultParams())
+	f.ctx = f.ctx.WithBlockHeight(10)
+	msgServer := vbank.NewMsgServerImpl(f.vbankKeeper)
+	authority := authtypes.NewModuleAddress("gov").String()
+
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	ubld := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("ubld", amt)) }
+	limit := vbanktypes.OutflowLimit{Denom: "ubld", Amount: sdkmath.NewInt(100), WindowBlocks: 5}
+
+	_, err := msgServer.SetOutflowLimit(f.ctx, &vbanktypes.MsgSetOutflowLimit{Authority: addr1.String(), Limit: limit})
+	require.ErrorContains(t, err, "only governance authority")
+	_, err = msgServer.SetOutflowLimit(f.ctx, &vbanktypes.MsgSetOutflowLimit{Authority: authority, Limit: limit})
+	require.NoError(t, err)
+	require.Equal(t, []vbanktypes.OutflowLimit{limit}, f.vbankKeeper.GetParams(f.ctx).OutflowLimits)
+
+	// Unlimited denoms are not tracked.
+	require.NoError(t, f.vbankKeeper.SendCoins(f.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("urun", 1000))))
+
+	require.NoError(t, f.vbankKeeper.SendCoins(f.ctx, addr1, ubld(60)))
+	require.NoError(t, f.vbankKeeper.SendCoins(f.ctx, addr1, ubld(40)))
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	err = f.vbankKeeper.SendCoins(f.ctx, addr1, ubld(1))
+	require.ErrorIs(t, err, vbanktypes.ErrOutflowLimitExceeded)
+	require.Equal(t, sdkmath.NewInt(100), f.bankKeeper.GetBalance(f.ctx, addr1, "ubld").Amount)
+	events := f.ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, vbanktypes.EventTypeOutflowLimitTripped, events[0].Type)
+
+	// Inflows offset outflows within the window.
+	require.NoError(t, f.vbankKeeper.GrabCoins(f.ctx, addr1, ubld(30)))
+	require.NoError(t, f.vbankKeeper.SendCoins(f.ctx, addr1, ubld(30)))
+	require.ErrorIs(t, f.vbankKeeper.SendCoins(f.ctx, addr1, ubld(1)), vbanktypes.ErrOutflowLimitExceeded)
+
+	// A new window starts afresh.
+	f.ctx = f.ctx.WithBlockHeight(15)
+	require.NoError(t, f.vbankKeeper.SendCoins(f.ctx, addr1, ubld(100)))
+	require.ErrorIs(t, f.vbankKeeper.SendCoins(f.ctx, addr1, ubld(1)), vbanktypes.ErrOutflowLimitExceeded)
+
+	// Setting the limit again resets the window.
+	_, err = msgServer.SetOutflowLimit(f.ctx, &vbanktypes.MsgSetOutflowLimit{Authority: authority, Limit: limit})
+	require.NoError(t, err)
+	require.NoError(t, f.vbankKeeper.SendCoins(f.ctx, addr1, ubld(100)))
+
+	// A zero amount removes the limit.
+	limit.Amount = sdkmath.ZeroInt()
+	_, err = msgServer.SetOutflowLimit(f.ctx, &vbanktypes.MsgSetOutflowLimit{Authority: authority, Limit: limit})
+	require.NoError(t, err)
+	require.Empty(t, f.vbankKeeper.GetParams(f.ctx).OutflowLimits)
+	require.NoError(t, f.vbankKeeper.SendCoins(f.ctx, addr1, ubld(1000)))
+
+	// An unset amount also removes the limit.
+	limit.Amount = sdkmath.NewInt(100)
+	_, err = msgServer.SetOutflowLimit(f.ctx, &vbanktypes.MsgSetOutflowLimit{Authority: authority, Limit: limit})
+	require.NoError(t, err)
+	unset := &vbanktypes.MsgSetOutflowLimit{Authority: authority, Limit: vbanktypes.OutflowLimit{Denom: "ubld"}}
+	require.True(t, unset.Limit.Amount.IsNil())
+	require.NoError(t, unset.ValidateBasic())
+	_, err = msgServer.SetOutflowLimit(f.ctx, unset)
+	require.NoError(t, err)
+	require.Empty(t, f.vbankKeeper.GetParams(f.ctx).OutflowLimits)
+}
+
+func Test_GenesisRoundTrip(t *testing.T) {
+	t.Parallel()
+	f := initVbankFixtures(t)
+	f.vbankKeeper.SetParams(f.ctx, vbanktypes.DefaultParams())
+
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+
+	_, err := f.vbankKeeper.AddWatch(f.ctx, addr1.String(), "ubld")
+	require.NoError(t, err)
+	_, err = f.vbankKeeper.AddWatch(f.ctx, addr1.String(), "ubld")
+	require.NoError(t, err)
+	_, err = f.vbankKeeper.AddWatch(f.ctx, addr2.String(), vbanktypes.WatchAllDenoms)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = f.vbankKeeper.GetNextSequence(f.ctx)
+		require.NoError(t, err)
+	}
+
+	exported, err := vbank.ExportGenesis(f.ctx, f.vbankKeeper)
+	require.NoError(t, err)
+	require.NoError(t, vbank.ValidateGenesis(exported))
+	require.Equal(t, uint64(3), exported.State.LastSequence)
+	require.ElementsMatch(t, []vbanktypes.Watch{
+		{Address: addr1.String(), Denom: "ubld", Count: 2},
+		{Address: addr2.String(), Denom: vbanktypes.WatchAllDenoms, Count: 1},
+	}, exported.Watches)
+
+	g := initVbankFixtures(t)
+	vbank.InitGenesis(g.ctx, g.vbankKeeper, exported)
+	reexported, err := vbank.ExportGenesis(g.ctx, g.vbankKeeper)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	seq, err := g.vbankKeeper.GetNextSequence(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), seq)
 
+	bad := *exported
+	bad.Watches = []vbanktypes.Watch{{Address: addr1.String(), Denom: "ubld"}}
+	require.ErrorContains(t, vbank.ValidateGenesis(&bad), "no registrations")
 }
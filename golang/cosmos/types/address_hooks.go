@@ -3,11 +3,15 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
@@ -23,6 +27,25 @@ const (
 
 	AddressHookVersion     = 0
 	BaseAddressLengthBytes = 2
+
+	// DefaultHookedAddressCharLimit is the maximum number of characters in a
+	// bech32-encoded hooked address.
+	DefaultHookedAddressCharLimit = 1024
+
+	// AddressHookQueryPrefix begins hook data that is an HTTP query string,
+	// which is the only structured format of hook data so far.
+	AddressHookQueryPrefix = "?"
+
+	// AddressHookPayloadVersion is the version of the AddressHookPayload keys,
+	// given by the "v" key of the hook query.
+	AddressHookPayloadVersion = 1
+
+	// The keys of the hook query that AddressHookPayload defines.
+	AddressHookKeyVersion         = "v"
+	AddressHookKeyDestination     = "dest"
+	AddressHookKeyMemo            = "memo"
+	AddressHookKeyForwardChannel  = "fwdChannel"
+	AddressHookKeyForwardReceiver = "fwdReceiver"
 )
 
 // AddressHookBytePrefix is a magic prefix that identifies a hooked address.
@@ -121,6 +144,194 @@ func JoinHookedAddress(baseAddr string, hookData []byte) (string, error) {
 	return bech32.ConvertAndEncode(prefix, payload)
 }
 
+// EncodeAddressHook joins a base bech32 address with a query to create a
+// hooked bech32 address whose hook data is "?" followed by the encoded query.
+// For the JS implementation, look at @agoric/cosmic-proto/src/address-hooks.js.
+func EncodeAddressHook(baseAddr string, query url.Values) (string, error) {
+	for key := range query {
+		if len(key) == 0 {
+			return "", fmt.Errorf("address hook query keys must not be empty")
+		}
+	}
+	hookData := []byte(AddressHookQueryPrefix + query.Encode())
+	addr, err := JoinHookedAddress(baseAddr, hookData)
+	if err != nil {
+		return "", err
+	}
+	if len(addr) > DefaultHookedAddressCharLimit {
+		return "", fmt.Errorf("hooked address length %d exceeds the limit %d", len(addr), DefaultHookedAddressCharLimit)
+	}
+	return addr, nil
+}
+
+// DecodeAddressHook splits a hooked address into its base address and the
+// query of its hook data.  It returns addr verbatim and an empty query if addr
+// is not an Address Hook.
+// For the JS implementation, look at @agoric/cosmic-proto/src/address-hooks.js.
+func DecodeAddressHook(addr string) (string, url.Values, error) {
+	baseAddr, hookData, err := SplitHookedAddress(addr)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(hookData) == 0 {
+		return baseAddr, url.Values{}, nil
+	}
+
+	hookStr := string(hookData)
+	if !strings.HasPrefix(hookStr, AddressHookQueryPrefix) {
+		return "", nil, fmt.Errorf("hook data of %s does not start with %q", addr, AddressHookQueryPrefix)
+	}
+	query, err := url.ParseQuery(strings.TrimPrefix(hookStr, AddressHookQueryPrefix))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid address hook query: %w", err)
+	}
+	return baseAddr, query, nil
+}
+
+// AddressHookForward instructs the VM to forward the tokens received by a
+// hooked address over another IBC channel.
+type AddressHookForward struct {
+	Channel  string `json:"channel"`
+	Receiver string `json:"receiver"`
+}
+
+// AddressHookPayload is the typed content of a hook query.  The keys that it
+// does not define are kept in Extra, for the VM to interpret.
+type AddressHookPayload struct {
+	Version     int                 `json:"version"`
+	Destination string              `json:"destination,omitempty"`
+	Memo        string              `json:"memo,omitempty"`
+	Forward     *AddressHookForward `json:"forward,omitempty"`
+	Extra       url.Values          `json:"extra,omitempty"`
+}
+
+// ParseAddressHookPayload validates the keys of query that AddressHookPayload
+// defines, each of which may be given only once, and decodes the query.  A
+// query without a version is of the current version, so that hooks predating
+// the payload remain valid.
+func ParseAddressHookPayload(query url.Values) (AddressHookPayload, error) {
+	payload := AddressHookPayload{Version: AddressHookPayloadVersion}
+	single := func(key string) (string, error) {
+		values := query[key]
+		if len(values) > 1 {
+			return "", fmt.Errorf("address hook key %q must not be repeated", key)
+		}
+		if len(values) == 0 {
+			return "", nil
+		}
+		return values[0], nil
+	}
+
+	version, err := single(AddressHookKeyVersion)
+	if err != nil {
+		return payload, err
+	}
+	if len(version) > 0 {
+		payload.Version, err = strconv.Atoi(version)
+		if err != nil || payload.Version != AddressHookPayloadVersion {
+			return payload, fmt.Errorf("unsupported address hook payload version %q", version)
+		}
+	}
+	if payload.Destination, err = single(AddressHookKeyDestination); err != nil {
+		return payload, err
+	}
+	if payload.Memo, err = single(AddressHookKeyMemo); err != nil {
+		return payload, err
+	}
+	forward := AddressHookForward{}
+	if forward.Channel, err = single(AddressHookKeyForwardChannel); err != nil {
+		return payload, err
+	}
+	if forward.Receiver, err = single(AddressHookKeyForwardReceiver); err != nil {
+		return payload, err
+	}
+	if len(forward.Channel) > 0 || len(forward.Receiver) > 0 {
+		payload.Forward = &forward
+	}
+
+	for key, values := range query {
+		switch key {
+		case AddressHookKeyVersion, AddressHookKeyDestination, AddressHookKeyMemo,
+			AddressHookKeyForwardChannel, AddressHookKeyForwardReceiver:
+			continue
+		}
+		if payload.Extra == nil {
+			payload.Extra = url.Values{}
+		}
+		payload.Extra[key] = values
+	}
+	return payload, payload.ValidateBasic()
+}
+
+// ValidateBasic checks the fields of the payload.
+func (p AddressHookPayload) ValidateBasic() error {
+	if p.Version != AddressHookPayloadVersion {
+		return fmt.Errorf("unsupported address hook payload version %d", p.Version)
+	}
+	if len(p.Destination) > 0 {
+		if _, _, err := bech32.DecodeAndConvert(p.Destination); err != nil {
+			return fmt.Errorf("invalid address hook destination %q: %w", p.Destination, err)
+		}
+	}
+	if p.Forward != nil {
+		if err := host.ChannelIdentifierValidator(p.Forward.Channel); err != nil {
+			return fmt.Errorf("invalid address hook forward channel: %w", err)
+		}
+		if len(p.Forward.Receiver) == 0 {
+			return fmt.Errorf("address hook forward requires a receiver")
+		}
+	}
+	for key := range p.Extra {
+		if len(key) == 0 {
+			return fmt.Errorf("address hook query keys must not be empty")
+		}
+	}
+	return nil
+}
+
+// Query returns the hook query of the payload.
+func (p AddressHookPayload) Query() url.Values {
+	query := url.Values{}
+	for key, values := range p.Extra {
+		query[key] = values
+	}
+	query.Set(AddressHookKeyVersion, strconv.Itoa(p.Version))
+	if len(p.Destination) > 0 {
+		query.Set(AddressHookKeyDestination, p.Destination)
+	}
+	if len(p.Memo) > 0 {
+		query.Set(AddressHookKeyMemo, p.Memo)
+	}
+	if p.Forward != nil {
+		query.Set(AddressHookKeyForwardChannel, p.Forward.Channel)
+		query.Set(AddressHookKeyForwardReceiver, p.Forward.Receiver)
+	}
+	return query
+}
+
+// EncodeAddressHookPayload validates payload and joins it with a base bech32
+// address to create a hooked bech32 address.
+func EncodeAddressHookPayload(baseAddr string, payload AddressHookPayload) (string, error) {
+	if err := payload.ValidateBasic(); err != nil {
+		return "", err
+	}
+	return EncodeAddressHook(baseAddr, payload.Query())
+}
+
+// DecodeAddressHookPayload splits a hooked address into its base address and
+// the validated payload of its hook query.
+func DecodeAddressHookPayload(addr string) (string, AddressHookPayload, error) {
+	baseAddr, query, err := DecodeAddressHook(addr)
+	if err != nil {
+		return "", AddressHookPayload{}, err
+	}
+	payload, err := ParseAddressHookPayload(query)
+	if err != nil {
+		return "", AddressHookPayload{}, err
+	}
+	return baseAddr, payload, nil
+}
+
 // extractBaseTransferData returns the base address from the transferData.Sender
 // (if RoleSender) or transferData.Receiver (if RoleReceiver). Errors in
 // determining the base address are ignored... we then assume the base address
@@ -202,6 +413,8 @@ func ExtractBaseAddressFromPacket(cdc codec.Codec, packet ibcexported.PacketI, r
 // address is exactly the original address.
 // If newDataP is not nil, it is populated with new transfer packet data whose
 // corresponding Sender or Receiver is replaced with the extracted base address.
+// Only ics20-1 JSON packet data exists here: ibc-go v10 dropped ICS-20 v2
+// (multi-denom tokens and forwarding), so transfer channels never negotiate it.
 func ExtractBaseAddressFromData(cdc codec.Codec, data []byte, role AddressRole, newDataP *[]byte) (string, error) {
 	transferData := transfertypes.FungibleTokenPacketData{}
 
@@ -233,3 +446,22 @@ func ExtractBaseAddressFromData(cdc codec.Codec, data []byte, role AddressRole,
 
 	return target, nil
 }
+
+// ExtractAddressHookFromData returns the base address and hook query of a
+// transfer packet's data, either Sender (if role is RoleSender) or Receiver (if
+// role is RoleReceiver).  The query is empty if the address is not hooked.
+func ExtractAddressHookFromData(cdc codec.Codec, data []byte, role AddressRole) (string, url.Values, error) {
+	transferData := transfertypes.FungibleTokenPacketData{}
+	if err := cdc.UnmarshalJSON(data, &transferData); err != nil {
+		return "", nil, err
+	}
+
+	switch role {
+	case RoleSender:
+		return DecodeAddressHook(transferData.Sender)
+	case RoleReceiver:
+		return DecodeAddressHook(transferData.Receiver)
+	default:
+		return "", nil, fmt.Errorf("invalid address role: %s", role)
+	}
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -112,6 +113,71 @@ func TestExtractBaseAddress(t *testing.T) {
 	}
 }
 
+func TestAddressHookQuery(t *testing.T) {
+	// Same as the example of @agoric/cosmic-proto/src/address-hooks.js.
+	query := url.Values{"key": {"value"}, "foo": {"bar", "baz"}}
+	addrHook, err := agtypes.EncodeAddressHook("agoric1qqp0e5ys", query)
+	require.NoError(t, err)
+	require.Equal(t, "agoric10rchqqplvehk70tzv9ezven0du7kyct6ye4k27faweskcat9qqqstnf2eq", addrHook)
+
+	baseAddr, decoded, err := agtypes.DecodeAddressHook(addrHook)
+	require.NoError(t, err)
+	require.Equal(t, "agoric1qqp0e5ys", baseAddr)
+	require.Equal(t, query, decoded)
+
+	baseAddr, decoded, err = agtypes.DecodeAddressHook("agoric1qqp0e5ys")
+	require.NoError(t, err)
+	require.Equal(t, "agoric1qqp0e5ys", baseAddr)
+	require.Empty(t, decoded)
+
+	_, err = agtypes.EncodeAddressHook("agoric1qqp0e5ys", url.Values{"": {"value"}})
+	require.ErrorContains(t, err, "keys must not be empty")
+	_, err = agtypes.EncodeAddressHook("agoric1qqp0e5ys", url.Values{"memo": {string(make([]byte, 1000))}})
+	require.ErrorContains(t, err, "exceeds the limit")
+
+	for _, hookStr := range []string{"/sub/account", "?bad=%zz"} {
+		addrHook, err := agtypes.JoinHookedAddress("agoric1qqp0e5ys", []byte(hookStr))
+		require.NoError(t, err)
+		_, _, err = agtypes.DecodeAddressHook(addrHook)
+		require.Error(t, err, hookStr)
+	}
+}
+
+func TestAddressHookPayload(t *testing.T) {
+	payload := agtypes.AddressHookPayload{
+		Version:     agtypes.AddressHookPayloadVersion,
+		Destination: "cosmos1qqxuevtt",
+		Memo:        "hello",
+		Forward:     &agtypes.AddressHookForward{Channel: "channel-1", Receiver: "osmo1receiver"},
+		Extra:       url.Values{"app": {"swap"}},
+	}
+	addrHook, err := agtypes.EncodeAddressHookPayload("agoric1qqp0e5ys", payload)
+	require.NoError(t, err)
+	baseAddr, decoded, err := agtypes.DecodeAddressHookPayload(addrHook)
+	require.NoError(t, err)
+	require.Equal(t, "agoric1qqp0e5ys", baseAddr)
+	require.Equal(t, payload, decoded)
+
+	// A query without a version is of the current one.
+	decoded, err = agtypes.ParseAddressHookPayload(url.Values{"key": {"value"}})
+	require.NoError(t, err)
+	require.Equal(t, agtypes.AddressHookPayload{Version: 1, Extra: url.Values{"key": {"value"}}}, decoded)
+
+	for _, bad := range []struct {
+		query url.Values
+		err   string
+	}{
+		{url.Values{"v": {"2"}}, "unsupported address hook payload version"},
+		{url.Values{"memo": {"a", "b"}}, "must not be repeated"},
+		{url.Values{"dest": {"not-bech32"}}, "invalid address hook destination"},
+		{url.Values{"fwdChannel": {"bad"}, "fwdReceiver": {"osmo1receiver"}}, "invalid address hook forward channel"},
+		{url.Values{"fwdChannel": {"channel-1"}}, "requires a receiver"},
+	} {
+		_, err := agtypes.ParseAddressHookPayload(bad.query)
+		require.ErrorContains(t, err, bad.err, bad.query.Encode())
+	}
+}
+
 func TestExtractBaseAddressFromPacket(t *testing.T) {
 	ir := cdctypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(ir)
@@ -214,6 +280,10 @@ func TestExtractBaseAddressFromPacket(t *testing.T) {
 					}
 
 					require.Equal(t, ftPacketData, packetData)
+
+					hookBaseAddr, _, err := agtypes.ExtractAddressHookFromData(cdc, packet.GetData(), role)
+					require.NoError(t, err)
+					require.Equal(t, addrs.baseAddr, hookBaseAddr)
 				})
 			}
 		})
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Categories of the causes of coin movements, by which x/vbank accounts for
+// the flows into and out of its pools.
+const (
+	PoolFlowCategoryAdmissionFee    = "admission_fee"
+	PoolFlowCategoryProvisioningFee = "provisioning_fee"
+	PoolFlowCategoryTxFee           = "tx_fee"
+	PoolFlowCategoryVbankGive       = "vbank_give"
+	PoolFlowCategoryVbankGrab       = "vbank_grab"
+	PoolFlowCategoryVbankTransfer   = "vbank_transfer"
+	PoolFlowCategoryRewards         = "rewards"
+	// PoolFlowCategoryOther is the category of movements made without one.
+	PoolFlowCategoryOther = "other"
+)
+
+type poolFlowCategoryKey struct{}
+
+// WithPoolFlowCategory returns a context in which coin movements are
+// attributed to the given category.
+func WithPoolFlowCategory(ctx sdk.Context, category string) sdk.Context {
+	return ctx.WithValue(poolFlowCategoryKey{}, category)
+}
+
+// PoolFlowCategory returns the category of coin movements made within ctx, or
+// the empty string if none was set.
+func PoolFlowCategory(ctx context.Context) string {
+	category, _ := ctx.Value(poolFlowCategoryKey{}).(string)
+	return category
+}
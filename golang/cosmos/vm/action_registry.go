@@ -0,0 +1,174 @@
+package vm
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// actionRegistry records the Go shape of every action that may be sent to the
+// controller, and of every message accepted by a bridge port, so that they can
+// be validated at run time and described to the JS side.
+type actionRegistry struct {
+	mtx sync.RWMutex
+	// actions[t] lists the struct types whose `actionType:"..."` tag is t
+	actions map[string][]reflect.Type
+	// aliases[a] is the registered action type that a stands for
+	aliases map[string]string
+	// portMessages[p] lists the struct types of messages accepted by port p
+	portMessages map[string][]reflect.Type
+}
+
+var registry = &actionRegistry{
+	actions:      make(map[string][]reflect.Type),
+	aliases:      make(map[string]string),
+	portMessages: make(map[string][]reflect.Type),
+}
+
+// structType returns the struct type underlying val, or nil if there is none.
+func structType(val interface{}) reflect.Type {
+	if val == nil {
+		return nil
+	}
+	t := reflect.TypeOf(val)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	return t
+}
+
+// actionTypeOf returns the `actionType:"..."` tag of the ActionHeader embedded
+// in t.
+func actionTypeOf(t reflect.Type) string {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Type != actionHeaderType && field.Type != reflect.PointerTo(actionHeaderType) {
+			continue
+		}
+		actionType, _ := field.Tag.Lookup("actionType")
+		return actionType
+	}
+	return ""
+}
+
+// RegisterAction records the shape of an action struct under the
+// `actionType:"..."` tag of its embedded ActionHeader, and returns that action
+// type.  Several shapes may share an action type (as for IBC_EVENT).  It panics
+// if the action has no such tag.
+func RegisterAction(action Action) string {
+	t := structType(action)
+	if t == nil {
+		panic(fmt.Errorf("cannot register non-struct action %T", action))
+	}
+	actionType := actionTypeOf(t)
+	if len(actionType) == 0 {
+		panic(fmt.Errorf("action %s has no `actionType:\"...\"` tag on its ActionHeader", t))
+	}
+
+	registry.mtx.Lock()
+	defer registry.mtx.Unlock()
+	if _, ok := registry.aliases[actionType]; ok {
+		panic(fmt.Errorf("action type %q is already registered as an alias", actionType))
+	}
+	if !slices.Contains(registry.actions[actionType], t) {
+		registry.actions[actionType] = append(registry.actions[actionType], t)
+	}
+	return actionType
+}
+
+// RegisterActions calls RegisterAction for each of the given actions.
+func RegisterActions(actions ...Action) {
+	for _, action := range actions {
+		RegisterAction(action)
+	}
+}
+
+// RegisterActionTypeAlias allows actions of the registered actionType to be
+// sent with ActionHeader.Type set to alias instead (as when vtransfer prefixes
+// the IBC_EVENTs it relays).
+func RegisterActionTypeAlias(alias, actionType string) {
+	registry.mtx.Lock()
+	defer registry.mtx.Unlock()
+	if _, ok := registry.actions[alias]; ok {
+		panic(fmt.Errorf("alias %q is already registered as an action type", alias))
+	}
+	if prior, ok := registry.aliases[alias]; ok && prior != actionType {
+		panic(fmt.Errorf("alias %q is already registered for %q", alias, prior))
+	}
+	registry.aliases[alias] = actionType
+}
+
+// RegisterPortMessage records the shape of a message struct accepted by the
+// named bridge port.
+func RegisterPortMessage(portName string, msg interface{}) {
+	t := structType(msg)
+	if t == nil {
+		panic(fmt.Errorf("cannot register non-struct port message %T", msg))
+	}
+
+	registry.mtx.Lock()
+	defer registry.mtx.Unlock()
+	if !slices.Contains(registry.portMessages[portName], t) {
+		registry.portMessages[portName] = append(registry.portMessages[portName], t)
+	}
+}
+
+// ValidateAction returns an error unless the action's ActionHeader.Type (or
+// the action type it is an alias for) has been registered with the action's
+// shape.  It should be called after PopulateAction.
+func ValidateAction(action Action) error {
+	t := structType(action)
+	if t == nil {
+		return fmt.Errorf("action %T is not a struct", action)
+	}
+	actionType := action.GetActionHeader().Type
+
+	registry.mtx.RLock()
+	defer registry.mtx.RUnlock()
+	canonical := actionType
+	if target, ok := registry.aliases[actionType]; ok {
+		canonical = target
+	}
+	shapes, ok := registry.actions[canonical]
+	if !ok {
+		return fmt.Errorf("action type %q is not registered", actionType)
+	}
+	if !slices.Contains(shapes, t) {
+		return fmt.Errorf("%s is not a registered shape of action type %q", t, actionType)
+	}
+	return nil
+}
+
+// IsActionTypeRegistered returns true iff actionType (or an alias) has been
+// registered.
+func IsActionTypeRegistered(actionType string) bool {
+	registry.mtx.RLock()
+	defer registry.mtx.RUnlock()
+	if _, ok := registry.aliases[actionType]; ok {
+		return true
+	}
+	_, ok := registry.actions[actionType]
+	return ok
+}
+
+// RegisteredActionTypes returns the sorted list of registered action types,
+// not including aliases.
+func RegisteredActionTypes() []string {
+	registry.mtx.RLock()
+	defer registry.mtx.RUnlock()
+	return sortedKeys(registry.actions)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,141 @@
+package vm_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+type registeredAction struct {
+	*vm.ActionHeader `actionType:"TEST_REGISTERED"`
+	Name             string            `json:"name"`
+	Kind             string            `json:"kind" default:"plain"`
+	Count            int64             `json:"count,omitempty"`
+	Data             []byte            `json:"data"`
+	Tags             map[string]string `json:"tags,omitempty"`
+}
+
+type otherRegisteredAction struct {
+	*vm.ActionHeader `actionType:"TEST_REGISTERED"`
+	Flag             bool `json:"flag"`
+}
+
+type untaggedAction struct {
+	vm.ActionHeader
+}
+
+type testPortMessage struct {
+	Type   string          `json:"type"`
+	Args   json.RawMessage `json:"args,omitempty"`
+	hidden string
+}
+
+func init() {
+	vm.RegisterActions(&registeredAction{}, &otherRegisteredAction{})
+	vm.RegisterActionTypeAlias("TEST_ALIASED", "TEST_REGISTERED")
+	vm.RegisterPortMessage("test", testPortMessage{})
+}
+
+func TestValidateAction(t *testing.T) {
+	testCases := []struct {
+		name    string
+		action  vm.Action
+		wantErr string
+	}{
+		{"registered", &registeredAction{ActionHeader: &vm.ActionHeader{Type: "TEST_REGISTERED"}}, ""},
+		{"second shape", otherRegisteredAction{ActionHeader: &vm.ActionHeader{Type: "TEST_REGISTERED"}}, ""},
+		{"alias", &registeredAction{ActionHeader: &vm.ActionHeader{Type: "TEST_ALIASED"}}, ""},
+		{"unregistered", &registeredAction{ActionHeader: &vm.ActionHeader{Type: "TEST_UNREGISTERED"}}, `action type "TEST_UNREGISTERED" is not registered`},
+		{"wrong shape", &untaggedAction{vm.ActionHeader{Type: "TEST_REGISTERED"}}, `is not a registered shape of action type "TEST_REGISTERED"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := vm.ValidateAction(tc.action)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateAction() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("ValidateAction() error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+
+	if !vm.IsActionTypeRegistered("TEST_ALIASED") {
+		t.Errorf("IsActionTypeRegistered(%q) = false", "TEST_ALIASED")
+	}
+}
+
+func TestRegisterActionWithoutTag(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("RegisterAction of an untagged action did not panic")
+		}
+	}()
+	vm.RegisterAction(&untaggedAction{})
+}
+
+func TestActionJSONSchema(t *testing.T) {
+	doc := vm.ActionJSONSchema()
+	if doc.Schema != vm.JSONSchemaDialect {
+		t.Errorf("$schema = %q, want %q", doc.Schema, vm.JSONSchemaDialect)
+	}
+
+	def := doc.Defs["TEST_REGISTERED"]
+	if def == nil || len(def.OneOf) != 2 {
+		t.Fatalf("TEST_REGISTERED definition = %+v, want 2 variants", def)
+	}
+	variant := def.OneOf[0]
+	if variant.Title != "registeredAction" {
+		t.Errorf("variant title = %q, want %q", variant.Title, "registeredAction")
+	}
+	if got := variant.Properties["type"].Const; got != "TEST_REGISTERED" {
+		t.Errorf("type const = %v, want %q", got, "TEST_REGISTERED")
+	}
+	if got := variant.Properties["kind"].Default; got != "plain" {
+		t.Errorf("kind default = %v, want %q", got, "plain")
+	}
+	if got := variant.Properties["data"].ContentEncoding; got != "base64" {
+		t.Errorf("data contentEncoding = %q, want %q", got, "base64")
+	}
+	wantRequired := []string{"type", "name", "kind", "data"}
+	if strings.Join(variant.Required, ",") != strings.Join(wantRequired, ",") {
+		t.Errorf("required = %v, want %v", variant.Required, wantRequired)
+	}
+
+	if got := doc.Defs["TEST_ALIASED"].OneOf[1].Properties["type"].Const; got != "TEST_ALIASED" {
+		t.Errorf("alias type const = %v, want %q", got, "TEST_ALIASED")
+	}
+
+	portDef := doc.Defs["testPortMessage"]
+	if portDef == nil {
+		t.Fatal("missing testPortMessage definition")
+	}
+	if _, ok := portDef.Properties["hidden"]; ok {
+		t.Error("unexported field is in the port message schema")
+	}
+
+	if _, err := json.Marshal(doc); err != nil {
+		t.Errorf("cannot marshal schema: %v", err)
+	}
+}
+
+func TestActionTypeScript(t *testing.T) {
+	ts := vm.ActionTypeScript()
+	for _, want := range []string{
+		"export type TEST_REGISTERED =\n  | {\n",
+		`    type: "TEST_REGISTERED";`,
+		"    count?: number;\n",
+		"    tags?: Record<string, string>;\n",
+		"export type testPortMessage = {\n  args?: unknown;\n  type: string;\n};\n",
+		"\n  | TEST_ALIASED",
+	} {
+		if !strings.Contains(ts, want) {
+			t.Errorf("ActionTypeScript() does not contain %q:\n%s", want, ts)
+		}
+	}
+}
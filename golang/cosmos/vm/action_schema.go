@@ -0,0 +1,278 @@
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// JSONSchemaDialect is the JSON Schema version of the documents returned by
+// ActionJSONSchema.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema is the subset of JSON Schema needed to describe the JSON
+// serialization of Go actions and port messages.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Defs                 map[string]*JSONSchema `json:"$defs,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Const                interface{}            `json:"const,omitempty"`
+	Default              interface{}            `json:"default,omitempty"`
+	ContentEncoding      string                 `json:"contentEncoding,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	OneOf                []*JSONSchema          `json:"oneOf,omitempty"`
+	AnyOf                []*JSONSchema          `json:"anyOf,omitempty"`
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	rawMessageType    = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// portMessageDefName returns the name under which the messages of portName are
+// defined.
+func portMessageDefName(portName string) string {
+	return portName + "PortMessage"
+}
+
+// ActionJSONSchema returns a JSON Schema document with a definition for every
+// registered action type (and alias) and port message, and which matches any
+// registered action.
+func ActionJSONSchema() *JSONSchema {
+	registry.mtx.RLock()
+	defer registry.mtx.RUnlock()
+
+	doc := &JSONSchema{
+		Schema: JSONSchemaDialect,
+		Title:  "Agoric VM actions and port messages",
+		Defs:   make(map[string]*JSONSchema),
+	}
+	addAction := func(actionType string, shapes []reflect.Type) {
+		def := &JSONSchema{}
+		for _, t := range shapes {
+			variant := typeSchema(t, actionType, map[reflect.Type]bool{})
+			variant.Title = t.Name()
+			def.OneOf = append(def.OneOf, variant)
+		}
+		if len(def.OneOf) == 1 {
+			def = def.OneOf[0]
+		}
+		doc.Defs[actionType] = def
+		doc.AnyOf = append(doc.AnyOf, &JSONSchema{Ref: "#/$defs/" + actionType})
+	}
+	for _, actionType := range sortedKeys(registry.actions) {
+		addAction(actionType, registry.actions[actionType])
+	}
+	for _, alias := range sortedKeys(registry.aliases) {
+		addAction(alias, registry.actions[registry.aliases[alias]])
+	}
+	for _, portName := range sortedKeys(registry.portMessages) {
+		def := &JSONSchema{}
+		for _, t := range registry.portMessages[portName] {
+			variant := typeSchema(t, "", map[reflect.Type]bool{})
+			variant.Title = t.Name()
+			def.OneOf = append(def.OneOf, variant)
+		}
+		if len(def.OneOf) == 1 {
+			def = def.OneOf[0]
+		}
+		def.Description = fmt.Sprintf("A message to the %q bridge port.", portName)
+		doc.Defs[portMessageDefName(portName)] = def
+	}
+	return doc
+}
+
+// typeSchema returns the schema of the JSON serialization of t.  actionType is
+// the constant type of any embedded ActionHeader, and seen holds the structs
+// being described (to cut off recursion).
+func typeSchema(t reflect.Type, actionType string, seen map[reflect.Type]bool) *JSONSchema {
+	if t == rawMessageType {
+		return &JSONSchema{}
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		return &JSONSchema{Description: fmt.Sprintf("%s (custom JSON encoding)", t)}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return typeSchema(t.Elem(), actionType, seen)
+	case reflect.Interface:
+		return &JSONSchema{}
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &JSONSchema{Type: "string", ContentEncoding: "base64"}
+		}
+		return &JSONSchema{Type: "array", Items: typeSchema(t.Elem(), "", seen)}
+	case reflect.Map:
+		return &JSONSchema{Type: "object", AdditionalProperties: typeSchema(t.Elem(), "", seen)}
+	case reflect.Struct:
+		if seen[t] {
+			return &JSONSchema{Description: fmt.Sprintf("%s (recursive)", t)}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		s := &JSONSchema{Type: "object", Properties: make(map[string]*JSONSchema)}
+		addStructProperties(s, t, actionType, seen)
+		return s
+	default:
+		return &JSONSchema{Description: fmt.Sprintf("%s (not serializable)", t)}
+	}
+}
+
+// addStructProperties adds the JSON properties of struct t to s, following
+// the field naming and embedding rules of encoding/json.
+func addStructProperties(s *JSONSchema, t reflect.Type, actionType string, seen map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		omitempty := strings.Contains(","+opts+",", ",omitempty,")
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft == actionHeaderType {
+				addActionHeaderProperties(s, actionType)
+				continue
+			}
+			if ft.Kind() == reflect.Struct && !ft.Implements(jsonMarshalerType) {
+				addStructProperties(s, ft, actionType, seen)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if _, ok := s.Properties[name]; ok {
+			continue
+		}
+
+		prop := typeSchema(field.Type, "", seen)
+		if def, ok := field.Tag.Lookup("default"); ok && prop.Type == "string" {
+			prop.Default = def
+		}
+		s.Properties[name] = prop
+		if !omitempty {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// addActionHeaderProperties adds the properties of an ActionHeader to s.
+func addActionHeaderProperties(s *JSONSchema, actionType string) {
+	typeProp := &JSONSchema{Type: "string"}
+	if len(actionType) > 0 {
+		typeProp.Const = actionType
+	}
+	s.Properties["type"] = typeProp
+	s.Properties["blockHeight"] = &JSONSchema{Type: "integer"}
+	s.Properties["blockTime"] = &JSONSchema{Type: "integer"}
+	s.Required = append(s.Required, "type")
+}
+
+var tsIdentifierRE = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// ActionTypeScript returns TypeScript definitions equivalent to
+// ActionJSONSchema().
+func ActionTypeScript() string {
+	doc := ActionJSONSchema()
+	var sb strings.Builder
+	sb.WriteString("// Code generated from the Go action registry. DO NOT EDIT.\n")
+	for _, name := range sortedKeys(doc.Defs) {
+		def := doc.Defs[name]
+		sb.WriteString("\n")
+		if len(def.Description) > 0 {
+			fmt.Fprintf(&sb, "/** %s */\n", def.Description)
+		}
+		typ := tsType(def, "")
+		if !strings.HasPrefix(typ, "\n") {
+			typ = " " + typ
+		}
+		fmt.Fprintf(&sb, "export type %s =%s;\n", name, typ)
+	}
+	sb.WriteString("\nexport type Action =")
+	for _, ref := range doc.AnyOf {
+		fmt.Fprintf(&sb, "\n  | %s", strings.TrimPrefix(ref.Ref, "#/$defs/"))
+	}
+	sb.WriteString(";\n")
+	return sb.String()
+}
+
+// tsType renders s as a TypeScript type expression at the given indentation.
+func tsType(s *JSONSchema, indent string) string {
+	if s.Const != nil {
+		bz, _ := json.Marshal(s.Const)
+		return string(bz)
+	}
+	if len(s.Ref) > 0 {
+		return strings.TrimPrefix(s.Ref, "#/$defs/")
+	}
+	if len(s.OneOf) > 0 {
+		var sb strings.Builder
+		for _, variant := range s.OneOf {
+			fmt.Fprintf(&sb, "\n%s  | %s", indent, tsType(variant, indent+"  "))
+		}
+		return sb.String()
+	}
+
+	switch s.Type {
+	case "boolean":
+		return "boolean"
+	case "integer", "number":
+		return "number"
+	case "string":
+		return "string"
+	case "array":
+		return fmt.Sprintf("Array<%s>", tsType(s.Items, indent))
+	case "object":
+		if s.Properties == nil {
+			if s.AdditionalProperties != nil {
+				return fmt.Sprintf("Record<string, %s>", tsType(s.AdditionalProperties, indent))
+			}
+			return "Record<string, unknown>"
+		}
+		var sb strings.Builder
+		sb.WriteString("{\n")
+		for _, name := range sortedKeys(s.Properties) {
+			key := name
+			if !tsIdentifierRE.MatchString(key) {
+				key = fmt.Sprintf("%q", key)
+			}
+			optional := "?"
+			if slices.Contains(s.Required, name) {
+				optional = ""
+			}
+			fmt.Fprintf(&sb, "%s  %s%s: %s;\n", indent, key, optional, tsType(s.Properties[name], indent+"  "))
+		}
+		sb.WriteString(indent + "}")
+		return sb.String()
+	default:
+		return "unknown"
+	}
+}
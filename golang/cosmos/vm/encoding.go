@@ -0,0 +1,137 @@
+package vm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Encoding names a serialization of Message.Data.
+type Encoding string
+
+const (
+	// EncodingJSON is the default encoding, accepted by every port.
+	EncodingJSON Encoding = "json"
+	// EncodingCBOR is the binary encoding of RFC 8949, accepted only by ports
+	// whose handler implements MultiEncodingPortHandler.
+	EncodingCBOR Encoding = "cbor"
+)
+
+// CBORPrefix is the self-described CBOR tag (RFC 8949 section 3.4.6) that
+// begins every CBOR item carried by Message.Data.
+const CBORPrefix = "\xd9\xd9\xf7"
+
+// CBORDataPrefix begins every CBOR-encoded Message.Data, which is the standard
+// base64 of the tagged CBOR item.  Message.Data crosses the bridge to the VM
+// as a NUL-terminated C string, and then as a JavaScript string, neither of
+// which can carry arbitrary bytes.  CBORDataPrefix is the base64 of
+// CBORPrefix, and no JSON text can begin with it, so the encoding of a message
+// can still be detected without extra framing.
+const CBORDataPrefix = "2dn3"
+
+var (
+	cborEncMode cbor.EncMode
+	cborDecMode cbor.DecMode
+)
+
+func init() {
+	var err error
+	// Use the Core Deterministic Encoding so that replies are byte-for-byte
+	// reproducible across validators.
+	cborEncMode, err = cbor.CoreDetEncOptions().EncMode()
+	if err != nil {
+		panic(err)
+	}
+	cborDecMode, err = cbor.DecOptions{
+		// Match encoding/json when decoding into an interface{}.
+		DefaultMapType: reflect.TypeOf(map[string]interface{}(nil)),
+	}.DecMode()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// MultiEncodingPortHandler is a PortHandler that also accepts encodings other
+// than JSON.  Its Receive method must decode the request with UnmarshalData
+// and encode its reply in EncodingFromContext(ctx).
+type MultiEncodingPortHandler interface {
+	PortHandler
+	// Encodings returns the non-JSON encodings that the handler accepts.
+	Encodings() []Encoding
+}
+
+// portHandlerEncodings returns all the encodings accepted by portHandler.
+func portHandlerEncodings(portHandler PortHandler) []Encoding {
+	encodings := []Encoding{EncodingJSON}
+	if meh, ok := portHandler.(MultiEncodingPortHandler); ok {
+		for _, enc := range meh.Encodings() {
+			if enc != EncodingJSON {
+				encodings = append(encodings, enc)
+			}
+		}
+	}
+	return encodings
+}
+
+// DetectEncoding returns the encoding of the given Message.Data.
+func DetectEncoding(data string) Encoding {
+	if strings.HasPrefix(data, CBORDataPrefix) {
+		return EncodingCBOR
+	}
+	return EncodingJSON
+}
+
+// UnmarshalData decodes data in whatever encoding it has into val, returning
+// that encoding.
+func UnmarshalData(data string, val interface{}) (Encoding, error) {
+	enc := DetectEncoding(data)
+	switch enc {
+	case EncodingCBOR:
+		bz, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return enc, fmt.Errorf("invalid base64 CBOR data: %w", err)
+		}
+		return enc, cborDecMode.Unmarshal(bz[len(CBORPrefix):], val)
+	default:
+		return enc, json.Unmarshal([]byte(data), val)
+	}
+}
+
+// MarshalData encodes val as Message.Data using the given encoding.
+func MarshalData(enc Encoding, val interface{}) (string, error) {
+	switch enc {
+	case EncodingJSON:
+		bz, err := json.Marshal(val)
+		return string(bz), err
+	case EncodingCBOR:
+		bz, err := cborEncMode.Marshal(val)
+		if err != nil {
+			return "", err
+		}
+		return base64.StdEncoding.EncodeToString(append([]byte(CBORPrefix), bz...)), nil
+	default:
+		return "", fmt.Errorf("unknown encoding %q", enc)
+	}
+}
+
+type encodingContextKey struct{}
+
+// WithEncoding returns a context that records the encoding of the message
+// being handled.
+func WithEncoding(ctx context.Context, enc Encoding) context.Context {
+	return context.WithValue(ctx, encodingContextKey{}, enc)
+}
+
+// EncodingFromContext returns the encoding recorded by WithEncoding, or
+// EncodingJSON if there is none.
+func EncodingFromContext(ctx context.Context) Encoding {
+	if enc, ok := ctx.Value(encodingContextKey{}).(Encoding); ok {
+		return enc
+	}
+	return EncodingJSON
+}
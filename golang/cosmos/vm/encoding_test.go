@@ -0,0 +1,255 @@
+package vm_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+// balanceUpdate has the shape of a vbank VBANK_BALANCE_UPDATE.
+type balanceUpdate struct {
+	*vm.ActionHeader `actionType:"VBANK_BALANCE_UPDATE"`
+	Nonce            uint64          `json:"nonce"`
+	Updated          []singleBalance `json:"updated"`
+}
+
+type singleBalance struct {
+	Address string `json:"address"`
+	Denom   string `json:"denom"`
+	Amount  string `json:"amount"`
+}
+
+// packetMessage has the shape of a vibc sendPacket request.
+type packetMessage struct {
+	Type              string `json:"type"`
+	Method            string `json:"method"`
+	Data              []byte `json:"data"`
+	RelativeTimeoutNs uint64 `json:"relativeTimeoutNs"`
+}
+
+func makeBalanceUpdate(n int) *balanceUpdate {
+	bu := &balanceUpdate{
+		ActionHeader: &vm.ActionHeader{Type: "VBANK_BALANCE_UPDATE", BlockHeight: 123456, BlockTime: 1700000000},
+		Nonce:        42,
+		Updated:      make([]singleBalance, n),
+	}
+	for i := range bu.Updated {
+		bu.Updated[i] = singleBalance{
+			Address: fmt.Sprintf("agoric1%038d", i),
+			Denom:   "ubld",
+			Amount:  fmt.Sprintf("%d", 1000000000+i),
+		}
+	}
+	return bu
+}
+
+func makePacketMessage(size int) *packetMessage {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	return &packetMessage{
+		Type:              "IBC_METHOD",
+		Method:            "sendPacket",
+		Data:              data,
+		RelativeTimeoutNs: 600_000_000_000,
+	}
+}
+
+func TestDetectEncoding(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+		want vm.Encoding
+	}{
+		{"empty", "", vm.EncodingJSON},
+		{"json object", `{"type":"VBANK_GET_BALANCE"}`, vm.EncodingJSON},
+		{"json string", `"Ù"`, vm.EncodingJSON},
+		{"cbor", vm.CBORDataPrefix + "9Q==", vm.EncodingCBOR},
+		{"truncated prefix", vm.CBORDataPrefix[:2], vm.EncodingJSON},
+		{"json number", `2`, vm.EncodingJSON},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := vm.DetectEncoding(tc.data); got != tc.want {
+				t.Errorf("DetectEncoding(%q) = %q, want %q", tc.data, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDataRoundTrip(t *testing.T) {
+	for _, enc := range []vm.Encoding{vm.EncodingJSON, vm.EncodingCBOR} {
+		t.Run(string(enc), func(t *testing.T) {
+			inputs := []interface{}{makeBalanceUpdate(3), makePacketMessage(64)}
+			for _, in := range inputs {
+				data, err := vm.MarshalData(enc, in)
+				if err != nil {
+					t.Fatalf("MarshalData(%T) error = %v", in, err)
+				}
+				out := reflect.New(reflect.TypeOf(in).Elem()).Interface()
+				gotEnc, err := vm.UnmarshalData(data, out)
+				if err != nil {
+					t.Fatalf("UnmarshalData(%T) error = %v", in, err)
+				}
+				if gotEnc != enc {
+					t.Errorf("UnmarshalData(%T) encoding = %q, want %q", in, gotEnc, enc)
+				}
+				if !reflect.DeepEqual(in, out) {
+					t.Errorf("round trip of %T = %+v, want %+v", in, out, in)
+				}
+			}
+		})
+	}
+}
+
+func TestMarshalDataCBORIsDeterministic(t *testing.T) {
+	val := map[string]interface{}{"zeta": 1, "alpha": []string{"x"}, "mid": true}
+	first, err := vm.MarshalData(vm.EncodingCBOR, val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		again, err := vm.MarshalData(vm.EncodingCBOR, val)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again != first {
+			t.Fatalf("CBOR encoding is not deterministic: %x != %x", again, first)
+		}
+	}
+}
+
+type echoHandler struct {
+	encodings []vm.Encoding
+}
+
+func (h echoHandler) Receive(ctx context.Context, str string) (string, error) {
+	var msg map[string]interface{}
+	enc, err := vm.UnmarshalData(str, &msg)
+	if err != nil {
+		return "", err
+	}
+	if ctxEnc := vm.EncodingFromContext(ctx); ctxEnc != enc {
+		return "", fmt.Errorf("context encoding %q, message encoding %q", ctxEnc, enc)
+	}
+	return vm.MarshalData(enc, msg["type"])
+}
+
+type multiEchoHandler struct {
+	echoHandler
+}
+
+func (h multiEchoHandler) Encodings() []vm.Encoding {
+	return h.encodings
+}
+
+func TestAgdServerEncodings(t *testing.T) {
+	s := vm.NewAgdServer()
+	jsonPort := s.MustRegisterPortHandler("json", echoHandler{})
+	cborPort := s.MustRegisterPortHandler("cbor", multiEchoHandler{echoHandler{[]vm.Encoding{vm.EncodingCBOR}}})
+
+	wantEncodings := map[int][]vm.Encoding{
+		cborPort: {vm.EncodingJSON, vm.EncodingCBOR},
+	}
+	if got := s.PortEncodings(); !reflect.DeepEqual(got, wantEncodings) {
+		t.Errorf("PortEncodings() = %v, want %v", got, wantEncodings)
+	}
+
+	cborMsg, err := vm.MarshalData(vm.EncodingCBOR, map[string]string{"type": "PING"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonMsg := `{"type":"PING"}`
+	cborReply, err := vm.MarshalData(vm.EncodingCBOR, "PING")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		port    int
+		data    string
+		want    string
+		wantErr string
+	}{
+		{"json to json port", jsonPort, jsonMsg, `"PING"`, ""},
+		{"json to cbor port", cborPort, jsonMsg, `"PING"`, ""},
+		{"cbor to cbor port", cborPort, cborMsg, cborReply, ""},
+		{"cbor to json port", jsonPort, cborMsg, "", "does not accept cbor encoding"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reply string
+			err := s.ReceiveMessage(&vm.Message{Port: tc.port, Data: tc.data, NeedsReply: true}, &reply)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("ReceiveMessage() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReceiveMessage() error = %v", err)
+			}
+			if reply != tc.want {
+				t.Errorf("ReceiveMessage() reply = %q, want %q", reply, tc.want)
+			}
+		})
+	}
+}
+
+var benchmarkPayloads = []struct {
+	name string
+	new  func() interface{}
+}{
+	{"balanceUpdate/10", func() interface{} { return makeBalanceUpdate(10) }},
+	{"balanceUpdate/1000", func() interface{} { return makeBalanceUpdate(1000) }},
+	{"packet/1KiB", func() interface{} { return makePacketMessage(1 << 10) }},
+	{"packet/64KiB", func() interface{} { return makePacketMessage(64 << 10) }},
+}
+
+func BenchmarkMarshalData(b *testing.B) {
+	for _, payload := range benchmarkPayloads {
+		for _, enc := range []vm.Encoding{vm.EncodingJSON, vm.EncodingCBOR} {
+			b.Run(payload.name+"/"+string(enc), func(b *testing.B) {
+				val := payload.new()
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					data, err := vm.MarshalData(enc, val)
+					if err != nil {
+						b.Fatal(err)
+					}
+					b.SetBytes(int64(len(data)))
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkUnmarshalData(b *testing.B) {
+	for _, payload := range benchmarkPayloads {
+		for _, enc := range []vm.Encoding{vm.EncodingJSON, vm.EncodingCBOR} {
+			b.Run(payload.name+"/"+string(enc), func(b *testing.B) {
+				val := payload.new()
+				data, err := vm.MarshalData(enc, val)
+				if err != nil {
+					b.Fatal(err)
+				}
+				typ := reflect.TypeOf(val).Elem()
+				b.SetBytes(int64(len(data)))
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					out := reflect.New(typ).Interface()
+					if _, err := vm.UnmarshalData(data, out); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -3,10 +3,13 @@ package vm
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // AgdServer manages communication from the VM to the ABCI app.  The structure
@@ -18,9 +21,23 @@ type AgdServer struct {
 	lastPort int
 	// portToHandler[i] is nonzero iff portToName[i] is nonempty
 	portToHandler map[int]PortHandler
+	// portToEncodings[i] lists the Message.Data encodings accepted by port i
+	portToEncodings map[int][]Encoding
+	// portToCapabilities[i] describes the protocol of port i
+	portToCapabilities map[int]PortCapabilities
 	// portToName[nameToPort[s]] == s && nameToPort[portToName[i]] == i for all i, s
 	portToName map[int]string
 	nameToPort map[string]int
+	// portStats[s] accumulates the calls made to the port named s
+	portStats map[string]*PortStats
+	// portCallBudgets, if non-nil, returns the number of calls per block to a
+	// port before further calls are rejected
+	portCallBudgets PortCallBudgetFunc
+	// blockCalls[s] is the number of calls to port s at blockCallsHeight
+	blockCalls       map[string]uint64
+	blockCallsHeight int64
+	// tracer, if non-nil, starts a span for each port call
+	tracer trace.Tracer
 }
 
 var wrappedEmptySDKContext = sdk.WrapSDKContext(
@@ -28,15 +45,21 @@ var wrappedEmptySDKContext = sdk.WrapSDKContext(
 )
 
 // NewAgdServer returns a pointer to a new AgdServer with empty context and port
-// mappings.
+// mappings other than the built-in AgdServerPort.
 func NewAgdServer() *AgdServer {
-	return &AgdServer{
-		currentCtx:    wrappedEmptySDKContext,
-		mtx:           sync.Mutex{},
-		portToHandler: make(map[int]PortHandler),
-		portToName:    make(map[int]string),
-		nameToPort:    make(map[string]int),
+	s := &AgdServer{
+		currentCtx:         wrappedEmptySDKContext,
+		mtx:                sync.Mutex{},
+		portToHandler:      make(map[int]PortHandler),
+		portToEncodings:    make(map[int][]Encoding),
+		portToCapabilities: make(map[int]PortCapabilities),
+		portToName:         make(map[int]string),
+		nameToPort:         make(map[string]int),
+		portStats:          make(map[string]*PortStats),
+		blockCalls:         make(map[string]uint64),
 	}
+	s.registerPortHandlerAt(AgdServerPort, AgdServerPortName, agdServerPortHandler{s})
+	return s
 }
 
 // SetControllerContext sets the context to the given argument and returns a function
@@ -54,23 +77,42 @@ func (s *AgdServer) SetControllerContext(ctx sdk.Context) func() {
 	}
 }
 
-// getContextAndHandler returns the current context and the handler for the
-// given port number.
-func (s *AgdServer) getContextAndHandler(port int) (context.Context, PortHandler) {
+// getContextAndHandler returns the current context, and the name, handler and
+// accepted encodings for the given port number.
+func (s *AgdServer) getContextAndHandler(port int) (context.Context, string, PortHandler, []Encoding) {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 	ctx := s.currentCtx
+	name := s.portToName[port]
 	handler := s.portToHandler[port]
-	return ctx, handler
+	encodings := s.portToEncodings[port]
+	return ctx, name, handler, encodings
 }
 
 // ReceiveMessage is the method the VM calls in order to have agd receive a
 // Message.
-func (s *AgdServer) ReceiveMessage(msg *Message, reply *string) error {
-	ctx, handler := s.getContextAndHandler(msg.Port)
+func (s *AgdServer) ReceiveMessage(msg *Message, reply *string) (err error) {
+	ctx, name, handler, encodings := s.getContextAndHandler(msg.Port)
 	if handler == nil {
 		return fmt.Errorf("unregistered port %d", msg.Port)
 	}
+	enc := DetectEncoding(msg.Data)
+	if enc != EncodingJSON {
+		if !slices.Contains(encodings, enc) {
+			return fmt.Errorf("port %d does not accept %s encoding", msg.Port, enc)
+		}
+		ctx = WithEncoding(ctx, enc)
+	}
+
+	start := time.Now()
+	height := blockHeightFromContext(ctx)
+	overBudget := s.chargePortCall(ctx, name)
+	ctx, span := s.startPortCall(ctx, name, msg.Port, enc, height, overBudget)
+	defer func() { s.finishPortCall(name, span, start, overBudget, err) }()
+	if overBudget {
+		return ErrPortCallBudgetExceeded
+	}
+
 	resp, err := handler.Receive(ctx, msg.Data)
 	*reply = resp
 	return err
@@ -84,6 +126,21 @@ func (s *AgdServer) GetPort(name string) int {
 	return s.nameToPort[name]
 }
 
+// PortEncodings returns the encodings accepted by each registered port that
+// accepts more than just JSON, for the VM to negotiate which ports it may send
+// binary messages to.
+func (s *AgdServer) PortEncodings() map[int][]Encoding {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	portToEncodings := make(map[int][]Encoding)
+	for port, encodings := range s.portToEncodings {
+		if len(encodings) > 1 {
+			portToEncodings[port] = slices.Clone(encodings)
+		}
+	}
+	return portToEncodings
+}
+
 // MustRegisterPortHandler attempts to RegisterPortHandler, panicing on error.
 func (s *AgdServer) MustRegisterPortHandler(name string, portHandler PortHandler) int {
 	port, err := s.RegisterPortHandler(name, portHandler)
@@ -102,12 +159,20 @@ func (s *AgdServer) RegisterPortHandler(name string, portHandler PortHandler) (i
 		return 0, fmt.Errorf("name %s already in use", name)
 	}
 	s.lastPort++
-	s.portToHandler[s.lastPort] = NewProtectedPortHandler(portHandler)
-	s.portToName[s.lastPort] = name
-	s.nameToPort[name] = s.lastPort
+	s.registerPortHandlerAt(s.lastPort, name, portHandler)
 	return s.lastPort, nil
 }
 
+// registerPortHandlerAt maps the port number and name to the handler.  The
+// caller must hold s.mtx or have exclusive access to s.
+func (s *AgdServer) registerPortHandlerAt(port int, name string, portHandler PortHandler) {
+	s.portToHandler[port] = NewProtectedPortHandler(portHandler)
+	s.portToEncodings[port] = portHandlerEncodings(portHandler)
+	s.portToCapabilities[port] = portHandlerCapabilities(portHandler)
+	s.portToName[port] = name
+	s.nameToPort[name] = port
+}
+
 // UnregisterPortHandler unregisters the handler and name mappings for this port
 // number, and the reverse mapping for its name, if any of these exist.  If
 // portNum is not registered, return an error.
@@ -118,6 +183,8 @@ func (s *AgdServer) UnregisterPortHandler(portNum int) error {
 		return fmt.Errorf("port %d not registered", portNum)
 	}
 	delete(s.portToHandler, portNum)
+	delete(s.portToEncodings, portNum)
+	delete(s.portToCapabilities, portNum)
 	name := s.portToName[portNum]
 	delete(s.portToName, portNum)
 	delete(s.nameToPort, name)
@@ -0,0 +1,160 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// AgdServerPortName is the name of the built-in port through which the VM
+	// discovers the other registered ports.
+	AgdServerPortName = "agdServer"
+	// AgdServerPort is the number of the built-in port.  It is reserved outside
+	// of the sequence of registered port numbers, which therefore still begins
+	// at 1 and is unchanged by the presence of the built-in port.
+	AgdServerPort = -1
+
+	agdServerPortVersion = "1"
+)
+
+// PortCapabilities describes the protocol spoken by a port handler.
+type PortCapabilities struct {
+	// Version identifies the revision of the port's protocol.
+	Version string `json:"version,omitempty"`
+	// Methods lists the message types or methods that the port accepts.
+	Methods []string `json:"methods,omitempty"`
+}
+
+// PortDescriber is a PortHandler that can describe its capabilities to the VM.
+type PortDescriber interface {
+	PortHandler
+	DescribePort() PortCapabilities
+}
+
+// PortDescription is what the VM learns about a registered port.
+type PortDescription struct {
+	Name      string     `json:"name"`
+	Port      int        `json:"port"`
+	Encodings []Encoding `json:"encodings"`
+	PortCapabilities
+}
+
+// portHandlerCapabilities returns the capabilities of portHandler, if it
+// describes them.
+func portHandlerCapabilities(portHandler PortHandler) PortCapabilities {
+	describer, ok := portHandler.(PortDescriber)
+	if !ok {
+		return PortCapabilities{}
+	}
+	caps := describer.DescribePort()
+	methods := append([]string{}, caps.Methods...)
+	sort.Strings(methods)
+	return PortCapabilities{Version: caps.Version, Methods: methods}
+}
+
+// describePortLocked returns the description of a registered port.  The mutex
+// must be held.
+func (s *AgdServer) describePortLocked(port int) PortDescription {
+	caps := s.portToCapabilities[port]
+	return PortDescription{
+		Name:      s.portToName[port],
+		Port:      port,
+		Encodings: append([]Encoding{}, s.portToEncodings[port]...),
+		PortCapabilities: PortCapabilities{
+			Version: caps.Version,
+			Methods: append([]string{}, caps.Methods...),
+		},
+	}
+}
+
+// ListPorts returns the descriptions of all registered ports, ordered by port
+// number.
+func (s *AgdServer) ListPorts() []PortDescription {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	ports := make([]int, 0, len(s.portToHandler))
+	for port := range s.portToHandler {
+		ports = append(ports, port)
+	}
+	sort.Ints(ports)
+	descs := make([]PortDescription, len(ports))
+	for i, port := range ports {
+		descs[i] = s.describePortLocked(port)
+	}
+	return descs
+}
+
+// DescribePort returns the description of the port registered under name.
+func (s *AgdServer) DescribePort(name string) (PortDescription, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	port, ok := s.nameToPort[name]
+	if !ok {
+		return PortDescription{}, fmt.Errorf("port name %q not registered", name)
+	}
+	return s.describePortLocked(port), nil
+}
+
+// agdServerPortHandler is the built-in handler for AgdServerPortName.
+type agdServerPortHandler struct {
+	server *AgdServer
+}
+
+var _ PortDescriber = agdServerPortHandler{}
+
+type agdServerMessage struct {
+	Method string `json:"method"`
+	// Name is the port to describe.
+	Name string `json:"name,omitempty"`
+	// RequiredMethods, if provided to describePort, must all be supported by
+	// the port or an error is returned.
+	RequiredMethods []string `json:"requiredMethods,omitempty"`
+}
+
+func init() {
+	RegisterPortMessage(AgdServerPortName, agdServerMessage{})
+}
+
+// DescribePort implements PortDescriber.
+func (h agdServerPortHandler) DescribePort() PortCapabilities {
+	return PortCapabilities{
+		Version: agdServerPortVersion,
+		Methods: []string{"describePort", "listPorts"},
+	}
+}
+
+// Receive implements PortHandler.
+func (h agdServerPortHandler) Receive(ctx context.Context, str string) (string, error) {
+	var msg agdServerMessage
+	enc, err := UnmarshalData(str, &msg)
+	if err != nil {
+		return "", err
+	}
+
+	switch msg.Method {
+	case "listPorts":
+		return MarshalData(enc, h.server.ListPorts())
+
+	case "describePort":
+		desc, err := h.server.DescribePort(msg.Name)
+		if err != nil {
+			return "", err
+		}
+		var missing []string
+		for _, method := range msg.RequiredMethods {
+			i := sort.SearchStrings(desc.Methods, method)
+			if i == len(desc.Methods) || desc.Methods[i] != method {
+				missing = append(missing, method)
+			}
+		}
+		if len(missing) > 0 {
+			return "", fmt.Errorf("port %q does not support required methods %s", msg.Name, strings.Join(missing, ", "))
+		}
+		return MarshalData(enc, desc)
+
+	default:
+		return "", fmt.Errorf("unrecognized %s method %q", AgdServerPortName, msg.Method)
+	}
+}
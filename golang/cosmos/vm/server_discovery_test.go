@@ -0,0 +1,104 @@
+package vm_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+type describedHandler struct {
+	echoHandler
+}
+
+func (h describedHandler) DescribePort() vm.PortCapabilities {
+	return vm.PortCapabilities{Version: "2", Methods: []string{"PONG", "PING"}}
+}
+
+func TestAgdServerDiscovery(t *testing.T) {
+	s := vm.NewAgdServer()
+	if got := s.GetPort(vm.AgdServerPortName); got != vm.AgdServerPort {
+		t.Fatalf("GetPort(%q) = %d, want %d", vm.AgdServerPortName, got, vm.AgdServerPort)
+	}
+	plainPort := s.MustRegisterPortHandler("plain", echoHandler{})
+	// The built-in port does not shift the numbers of the registered ports.
+	if plainPort != 1 {
+		t.Fatalf("first registered port = %d, want 1", plainPort)
+	}
+	describedPort := s.MustRegisterPortHandler("described", describedHandler{})
+
+	call := func(msg string) (string, error) {
+		var reply string
+		err := s.ReceiveMessage(&vm.Message{Port: vm.AgdServerPort, Data: msg, NeedsReply: true}, &reply)
+		return reply, err
+	}
+
+	reply, err := call(`{"method":"listPorts"}`)
+	if err != nil {
+		t.Fatalf("listPorts error = %v", err)
+	}
+	var ports []vm.PortDescription
+	if err := json.Unmarshal([]byte(reply), &ports); err != nil {
+		t.Fatalf("cannot unmarshal listPorts reply %s: %v", reply, err)
+	}
+	wantPorts := []vm.PortDescription{
+		{
+			Name:             vm.AgdServerPortName,
+			Port:             vm.AgdServerPort,
+			Encodings:        []vm.Encoding{vm.EncodingJSON},
+			PortCapabilities: vm.PortCapabilities{Version: "1", Methods: []string{"describePort", "listPorts"}},
+		},
+		{Name: "plain", Port: plainPort, Encodings: []vm.Encoding{vm.EncodingJSON}},
+		{
+			Name:             "described",
+			Port:             describedPort,
+			Encodings:        []vm.Encoding{vm.EncodingJSON},
+			PortCapabilities: vm.PortCapabilities{Version: "2", Methods: []string{"PING", "PONG"}},
+		},
+	}
+	if !reflect.DeepEqual(ports, wantPorts) {
+		t.Errorf("listPorts = %+v, want %+v", ports, wantPorts)
+	}
+
+	testCases := []struct {
+		name    string
+		msg     string
+		want    string
+		wantErr string
+	}{
+		{"describe", `{"method":"describePort","name":"described"}`,
+			`{"name":"described","port":2,"encodings":["json"],"version":"2","methods":["PING","PONG"]}`, ""},
+		{"required methods", `{"method":"describePort","name":"described","requiredMethods":["PONG"]}`,
+			`{"name":"described","port":2,"encodings":["json"],"version":"2","methods":["PING","PONG"]}`, ""},
+		{"missing methods", `{"method":"describePort","name":"described","requiredMethods":["PING","ZING","ZONG"]}`,
+			"", `port "described" does not support required methods ZING, ZONG`},
+		{"unknown port", `{"method":"describePort","name":"nope"}`, "", `port name "nope" not registered`},
+		{"unknown method", `{"method":"nope"}`, "", `unrecognized agdServer method "nope"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reply, err := call(tc.msg)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if reply != tc.want {
+				t.Errorf("reply = %s, want %s", reply, tc.want)
+			}
+		})
+	}
+
+	if err := s.UnregisterPortHandler(describedPort); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(s.ListPorts()); got != 2 {
+		t.Errorf("ListPorts() after unregister has %d ports, want 2", got)
+	}
+}
@@ -0,0 +1,194 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	metrics "github.com/hashicorp/go-metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	MetricKeyPortCalls           = []string{"agd_server", "port_calls"}
+	MetricKeyPortCallLatency     = []string{"agd_server", "port_call_latency"}
+	MetricKeyPortCallsOverBudget = []string{"agd_server", "port_calls_over_budget"}
+)
+
+// ErrPortCallBudgetExceeded is returned to the VM instead of dispatching a call
+// to a port whose per-block call budget is exhausted.  Its message is part of
+// consensus, so it must not vary between nodes or calls.
+var ErrPortCallBudgetExceeded = errors.New("port call budget exceeded")
+
+// PortCallBudgetFunc returns the number of calls per block that the VM may make
+// to the named port according to the consensus state in ctx, or false if the
+// port is unlimited.
+type PortCallBudgetFunc func(ctx sdk.Context, portName string) (uint64, bool)
+
+const (
+	MetricLabelPort   = "port"
+	MetricLabelResult = "result"
+
+	callResultOK    = "ok"
+	callResultError = "error"
+)
+
+// PortStats accumulates the calls that the VM has made to a named port since
+// the AgdServer was created.
+type PortStats struct {
+	// Calls is the number of calls made to the port.
+	Calls uint64 `json:"calls"`
+	// Errors is the number of calls that returned an error, including those
+	// rejected as over budget.
+	Errors uint64 `json:"errors"`
+	// OverBudget is the number of calls that were rejected because they
+	// exceeded the port's per-block call budget.
+	OverBudget uint64 `json:"overBudget"`
+	// TotalDuration is the time spent in the port handler over all calls.
+	TotalDuration time.Duration `json:"totalDuration"`
+	// MaxDuration is the time spent in the slowest call.
+	MaxDuration time.Duration `json:"maxDuration"`
+}
+
+// blockHeightFromContext returns the block height of the sdk.Context within
+// ctx, or 0 if there is none.
+func blockHeightFromContext(ctx context.Context) int64 {
+	if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+		return sdkCtx.BlockHeight()
+	}
+	return 0
+}
+
+// SetTracer enables OpenTelemetry spans for every port call, or disables them
+// if tracer is nil.
+func (s *AgdServer) SetTracer(tracer trace.Tracer) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.tracer = tracer
+}
+
+// SetPortCallBudgets sets the source of the number of calls that the VM may
+// make to each named port within a single block.  Further calls are rejected
+// with ErrPortCallBudgetExceeded, so budgets must come from consensus state
+// such as module parameters.  A nil budgets makes every port unlimited.
+func (s *AgdServer) SetPortCallBudgets(budgets PortCallBudgetFunc) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.portCallBudgets = budgets
+}
+
+// PortStats returns a snapshot of the call statistics of every port name that
+// has been called.
+func (s *AgdServer) PortStats() map[string]PortStats {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	stats := make(map[string]PortStats, len(s.portStats))
+	for name, st := range s.portStats {
+		stats[name] = *st
+	}
+	return stats
+}
+
+// chargePortCall counts a call to the named port against its budget for the
+// block of ctx, returning true if the budget is exhausted.  Calls made outside
+// of a block (at height 0) are not counted.
+func (s *AgdServer) chargePortCall(ctx context.Context, name string) bool {
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	if !ok || sdkCtx.BlockHeight() == 0 {
+		return false
+	}
+	s.mtx.Lock()
+	budgets := s.portCallBudgets
+	s.mtx.Unlock()
+	if budgets == nil {
+		return false
+	}
+	budget, ok := budgets(sdkCtx, name)
+	if !ok {
+		return false
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if height := sdkCtx.BlockHeight(); height != s.blockCallsHeight {
+		s.blockCallsHeight = height
+		s.blockCalls = make(map[string]uint64)
+	}
+	s.blockCalls[name]++
+	return s.blockCalls[name] > budget
+}
+
+// startPortCall begins a span for a call to the named port if tracing is
+// enabled.
+func (s *AgdServer) startPortCall(ctx context.Context, name string, port int, enc Encoding, height int64, overBudget bool) (context.Context, trace.Span) {
+	s.mtx.Lock()
+	tracer := s.tracer
+	s.mtx.Unlock()
+	if tracer == nil {
+		return ctx, nil
+	}
+	return tracer.Start(ctx, "agd_server.port_call",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("agd.port.name", name),
+			attribute.Int("agd.port.number", port),
+			attribute.String("agd.encoding", string(enc)),
+			attribute.Int64("agd.block.height", height),
+			attribute.Bool("agd.over_budget", overBudget),
+		),
+	)
+}
+
+// finishPortCall records the outcome of a call to the named port in the
+// server's statistics, the telemetry metrics, and any span.
+func (s *AgdServer) finishPortCall(name string, span trace.Span, start time.Time, overBudget bool, err error) {
+	elapsed := time.Since(start)
+	result := callResultOK
+	if err != nil {
+		result = callResultError
+	}
+
+	s.mtx.Lock()
+	st := s.portStats[name]
+	if st == nil {
+		st = &PortStats{}
+		s.portStats[name] = st
+	}
+	st.Calls++
+	if err != nil {
+		st.Errors++
+	}
+	if overBudget {
+		st.OverBudget++
+	}
+	st.TotalDuration += elapsed
+	if elapsed > st.MaxDuration {
+		st.MaxDuration = elapsed
+	}
+	s.mtx.Unlock()
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(MetricLabelPort, name),
+		telemetry.NewLabel(MetricLabelResult, result),
+	}
+	telemetry.IncrCounterWithLabels(MetricKeyPortCalls, 1, labels)
+	if overBudget {
+		telemetry.IncrCounterWithLabels(MetricKeyPortCallsOverBudget, 1, labels[:1])
+	}
+	if telemetry.IsTelemetryEnabled() {
+		metrics.MeasureSinceWithLabels(MetricKeyPortCallLatency, start.UTC(), labels[:1])
+	}
+
+	if span != nil {
+		span.SetAttributes(attribute.String("agd.result", result))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
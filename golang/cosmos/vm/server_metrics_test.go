@@ -0,0 +1,154 @@
+package vm_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+type failingHandler struct{}
+
+func (h failingHandler) Receive(ctx context.Context, str string) (string, error) {
+	return "", errors.New("failed")
+}
+
+func setBlockHeight(s *vm.AgdServer, height int64) func() {
+	ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(height)
+	return s.SetControllerContext(ctx)
+}
+
+func TestAgdServerPortStats(t *testing.T) {
+	s := vm.NewAgdServer()
+	echoPort := s.MustRegisterPortHandler("echo", echoHandler{})
+	failPort := s.MustRegisterPortHandler("fail", failingHandler{})
+
+	var reply string
+	for i := 0; i < 3; i++ {
+		if err := s.ReceiveMessage(&vm.Message{Port: echoPort, Data: `{"type":"PING"}`}, &reply); err != nil {
+			t.Fatalf("echo error = %v", err)
+		}
+	}
+	if err := s.ReceiveMessage(&vm.Message{Port: failPort, Data: `{}`}, &reply); err == nil {
+		t.Fatal("fail port did not fail")
+	}
+
+	stats := s.PortStats()
+	if got := stats["echo"]; got.Calls != 3 || got.Errors != 0 || got.OverBudget != 0 {
+		t.Errorf("echo stats = %+v, want 3 calls", got)
+	}
+	if got := stats["fail"]; got.Calls != 1 || got.Errors != 1 {
+		t.Errorf("fail stats = %+v, want 1 call with 1 error", got)
+	}
+	if got := stats["echo"]; got.MaxDuration > got.TotalDuration {
+		t.Errorf("echo max duration %s exceeds total %s", got.MaxDuration, got.TotalDuration)
+	}
+}
+
+func TestAgdServerPortCallBudgets(t *testing.T) {
+	s := vm.NewAgdServer()
+	limitedPort := s.MustRegisterPortHandler("limited", echoHandler{})
+	freePort := s.MustRegisterPortHandler("free", echoHandler{})
+	s.SetPortCallBudgets(func(ctx sdk.Context, portName string) (uint64, bool) {
+		if portName == "limited" {
+			return 2, true
+		}
+		return 0, false
+	})
+
+	call := func(port int) (string, error) {
+		var reply string
+		err := s.ReceiveMessage(&vm.Message{Port: port, Data: `{"type":"PING"}`}, &reply)
+		return reply, err
+	}
+
+	// Calls outside of a block are not counted.
+	for i := 0; i < 3; i++ {
+		if _, err := call(limitedPort); err != nil {
+			t.Fatalf("call outside block error = %v", err)
+		}
+	}
+
+	reset := setBlockHeight(s, 10)
+	for i := 0; i < 2; i++ {
+		if _, err := call(limitedPort); err != nil {
+			t.Fatalf("call %d in block 10 error = %v", i, err)
+		}
+	}
+	// Calls over budget are rejected without reaching the handler.
+	reply, err := call(limitedPort)
+	if err == nil || err.Error() != "port call budget exceeded" {
+		t.Fatalf("call over budget error = %v, want %q", err, vm.ErrPortCallBudgetExceeded)
+	}
+	if reply != "" {
+		t.Errorf("call over budget reply = %q, want none", reply)
+	}
+	for i := 0; i < 5; i++ {
+		if _, err := call(freePort); err != nil {
+			t.Fatalf("unbudgeted call error = %v", err)
+		}
+	}
+	reset()
+
+	defer setBlockHeight(s, 11)()
+	if _, err := call(limitedPort); err != nil {
+		t.Fatalf("call in block 11 error = %v", err)
+	}
+
+	if got := s.PortStats()["limited"]; got.Calls != 7 || got.Errors != 1 || got.OverBudget != 1 {
+		t.Errorf("limited stats = %+v, want 7 calls and 1 over budget", got)
+	}
+	if got := s.PortStats()["free"]; got.OverBudget != 0 {
+		t.Errorf("free stats = %+v, want none over budget", got)
+	}
+}
+
+func TestAgdServerTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	s := vm.NewAgdServer()
+	port := s.MustRegisterPortHandler("fail", failingHandler{})
+	var reply string
+
+	// No spans until a tracer is set.
+	_ = s.ReceiveMessage(&vm.Message{Port: port, Data: `{}`}, &reply)
+	if n := len(recorder.Ended()); n != 0 {
+		t.Fatalf("got %d spans without a tracer", n)
+	}
+
+	s.SetTracer(provider.Tracer("test"))
+	defer setBlockHeight(s, 42)()
+	err := s.ReceiveMessage(&vm.Message{Port: port, Data: `{}`}, &reply)
+	if err == nil {
+		t.Fatal("fail port did not fail")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range spans[0].Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	if got := attrs["agd.port.name"].AsString(); got != "fail" {
+		t.Errorf("port name attribute = %q, want %q", got, "fail")
+	}
+	if got := attrs["agd.block.height"].AsInt64(); got != 42 {
+		t.Errorf("block height attribute = %d, want 42", got)
+	}
+	if got := attrs["agd.result"].AsString(); got != "error" {
+		t.Errorf("result attribute = %q, want %q", got, "error")
+	}
+	if desc := spans[0].Status().Description; !strings.Contains(desc, "failed") {
+		t.Errorf("span status = %q, want the handler error", desc)
+	}
+}
@@ -32,6 +32,15 @@ type afterCommitBlockAction struct {
 	*vm.ActionHeader `actionType:"AFTER_COMMIT_BLOCK"`
 }
 
+func init() {
+	vm.RegisterActions(
+		&beginBlockAction{},
+		&endBlockAction{},
+		&commitBlockAction{},
+		&afterCommitBlockAction{},
+	)
+}
+
 func BeginBlock(ctx sdk.Context, keeper Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
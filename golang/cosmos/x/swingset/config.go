@@ -81,6 +81,10 @@ vat-snapshot-archive-dir = "{{ .Swingset.VatSnapshotArchiveDir }}"
 
 # Archival of historical (i.e., closed) vat transcript spans to gzipped files.
 vat-transcript-archive-dir = "{{ .Swingset.VatTranscriptArchiveDir }}"
+
+# Whether to emit an OpenTelemetry span for every call from the VM to a bridge
+# port, using the globally configured tracer provider.
+trace-port-calls = {{ .Swingset.TracePortCalls }}
 `
 
 // SwingsetConfig defines configuration for the SwingSet VM.
@@ -121,6 +125,10 @@ type SwingsetConfig struct {
 	// VatTranscriptArchiveDir controls archival of historical (i.e., closed) vat
 	// transcript spans to gzipped files.
 	VatTranscriptArchiveDir string `mapstructure:"vat-transcript-archive-dir" json:"vatTranscriptArchiveDir,omitempty"`
+
+	// TracePortCalls enables an OpenTelemetry span for every call from the VM
+	// to a bridge port.  It is not sent to the VM.
+	TracePortCalls bool `mapstructure:"trace-port-calls" json:"-"`
 }
 
 var DefaultSwingsetConfig = SwingsetConfig{
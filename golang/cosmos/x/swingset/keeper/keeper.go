@@ -105,6 +105,9 @@ func populateAction(ctx sdk.Context, action vm.Action) (vm.Action, error) {
 	if len(ah.Type) == 0 {
 		return nil, fmt.Errorf("action %q cannot have an empty ActionHeader.Type", action)
 	}
+	if err := vm.ValidateAction(action); err != nil {
+		return nil, err
+	}
 
 	return action, nil
 }
@@ -289,6 +292,20 @@ func (k Keeper) GetBeansPerUnit(ctx sdk.Context) map[string]sdkmath.Uint {
 	return beansPerUnit
 }
 
+// GetPortCallBudget returns the number of calls per block that the current
+// SwingSet parameters allow the VM to make to the named bridge port, and false
+// if they are unlimited.
+func (k Keeper) GetPortCallBudget(ctx sdk.Context, portName string) (uint64, bool) {
+	var entries []types.UintMapEntry
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyPortCallBudget, &entries)
+	for _, entry := range entries {
+		if entry.Key == portName {
+			return entry.Value.Uint64(), true
+		}
+	}
+	return 0, false
+}
+
 func getBeansOwingPathForAddress(addr sdk.AccAddress) string {
 	return StoragePathBeansOwing + "." + addr.String()
 }
@@ -313,13 +330,17 @@ func (k Keeper) SetBeansOwing(ctx sdk.Context, addr sdk.AccAddress, beans sdkmat
 
 // ChargeBeans charges the given address the given number of beans.  It divides
 // the beans into the number to debit immediately vs. the number to store in the
-// beansOwing.
+// beansOwing.  Unless the caller says otherwise, the debit is accounted as an
+// admission fee.
 func (k Keeper) ChargeBeans(
 	ctx sdk.Context,
 	beansPerUnit map[string]sdkmath.Uint,
 	addr sdk.AccAddress,
 	beans sdkmath.Uint,
 ) error {
+	if agoric.PoolFlowCategory(ctx) == "" {
+		ctx = agoric.WithPoolFlowCategory(ctx, agoric.PoolFlowCategoryAdmissionFee)
+	}
 	wasOwing := k.GetBeansOwing(ctx, addr)
 	nowOwing := wasOwing.Add(beans)
 
@@ -358,6 +379,7 @@ func (k Keeper) ChargeForSmartWallet(
 	addr sdk.AccAddress,
 ) error {
 	beans := beansPerUnit[types.BeansPerSmartWalletProvision]
+	ctx = agoric.WithPoolFlowCategory(ctx, agoric.PoolFlowCategoryProvisioningFee)
 	err := k.ChargeBeans(ctx, beansPerUnit, addr, beans)
 	if err != nil {
 		return err
@@ -426,6 +448,7 @@ func (k Keeper) ChargeForProvisioning(ctx sdk.Context, submitter sdk.AccAddress,
 	if fees.IsZero() {
 		return nil
 	}
+	ctx = agoric.WithPoolFlowCategory(ctx, agoric.PoolFlowCategoryProvisioningFee)
 	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitter, k.feeCollectorName, fees)
 }
 
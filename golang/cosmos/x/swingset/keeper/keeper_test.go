@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -10,6 +11,7 @@ import (
 	storemetrics "cosmossdk.io/store/metrics"
 	prefixstore "cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -267,3 +269,45 @@ func TestSwingStore(t *testing.T) {
 		t.Errorf("got export %q, want %q", gotEntries, expectedEntries)
 	}
 }
+
+type unregisteredAction struct {
+	*vm.ActionHeader `actionType:"UNREGISTERED_ACTION"`
+}
+
+type misshapenCoreEvalAction struct {
+	*vm.ActionHeader `actionType:"CORE_EVAL"`
+	Evals            string `json:"evals"`
+}
+
+func Test_populateAction(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(10)
+
+	testCases := []struct {
+		name    string
+		action  vm.Action
+		wantErr string
+	}{
+		{"registered", coreEvalAction{}, ""},
+		{"registered pointer", &coreEvalAction{}, ""},
+		{"unregistered type", unregisteredAction{}, `action type "UNREGISTERED_ACTION" is not registered`},
+		{"unregistered shape", misshapenCoreEvalAction{}, `is not a registered shape of action type "CORE_EVAL"`},
+		{"overridden type", coreEvalAction{ActionHeader: &vm.ActionHeader{Type: "CORE_EVIL"}}, `action type "CORE_EVIL" is not registered`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			action, err := populateAction(ctx, tc.action)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("populateAction() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("populateAction() error = %v", err)
+			}
+			if got := action.GetActionHeader(); got.Type != "CORE_EVAL" || got.BlockHeight != 10 {
+				t.Errorf("populateAction() header = %+v", got)
+			}
+		})
+	}
+}
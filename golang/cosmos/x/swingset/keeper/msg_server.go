@@ -207,6 +207,16 @@ type installBundleAction struct {
 	*types.MsgInstallBundle
 }
 
+func init() {
+	vm.RegisterActions(
+		&deliverInboundAction{},
+		&walletAction{},
+		&walletSpendAction{},
+		&provisionAction{},
+		&installBundleAction{},
+	)
+}
+
 func (keeper msgServer) validateMsgInstallBundle(goCtx context.Context, msg *types.MsgInstallBundle) error {
 	if err := msg.ValidateBasic(); err != nil {
 		return err
@@ -15,6 +15,10 @@ type coreEvalAction struct {
 	Evals            []types.CoreEval `json:"evals"`
 }
 
+func init() {
+	vm.RegisterAction(&coreEvalAction{})
+}
+
 // CoreEvalProposal tells SwingSet to evaluate the given JS code.
 func (k Keeper) CoreEvalProposal(ctx sdk.Context, p *types.CoreEvalProposal) error {
 	action := coreEvalAction{
@@ -22,6 +22,10 @@ type swingsetMessage struct {
 	Args   []json.RawMessage `json:"args"`
 }
 
+func init() {
+	vm.RegisterPortMessage("swingset", swingsetMessage{})
+}
+
 const (
 	SwingStoreUpdateExportData = "swingStoreUpdateExportData"
 )
@@ -31,6 +35,14 @@ func NewPortHandler(k Keeper) vm.PortHandler {
 	return portHandler{keeper: k}
 }
 
+// DescribePort implements vm.PortDescriber.
+func (ph portHandler) DescribePort() vm.PortCapabilities {
+	return vm.PortCapabilities{
+		Version: "1",
+		Methods: []string{SwingStoreUpdateExportData},
+	}
+}
+
 // Receive implements the vm.PortHandler method.
 // It receives and processes an inbound message, returning the
 // JSON-serialized response or an error.
@@ -22,6 +22,7 @@ var (
 	ParamStoreKeyChunkSizeLimitBytes              = []byte("chunk_size_limit_bytes")
 	ParamStoreKeyInstallationDeadlineSeconds      = []byte("installation_deadline_seconds")
 	ParamStoreKeyInstallationDeadlineBlocks       = []byte("installation_deadline_blocks")
+	ParamStoreKeyPortCallBudget                   = []byte("port_call_budget")
 )
 
 func NewStringBeans(key string, beans sdkmath.Uint) StringBeans {
@@ -84,6 +85,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyChunkSizeLimitBytes, &p.ChunkSizeLimitBytes, validateChunkSizeLimitBytes),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstallationDeadlineSeconds, &p.InstallationDeadlineSeconds, validateInstallationDeadlineSeconds),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstallationDeadlineBlocks, &p.InstallationDeadlineBlocks, validateInstallationDeadlineBlocks),
+		paramtypes.NewParamSetPair(ParamStoreKeyPortCallBudget, &p.PortCallBudget, validatePortCallBudget),
 	}
 }
 
@@ -119,6 +121,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateChunkSizeLimitBytes(p.ChunkSizeLimitBytes); err != nil {
 		return err
 	}
+	if err := validatePortCallBudget(p.PortCallBudget); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -213,6 +218,30 @@ func validateVatCleanupBudget(i interface{}) error {
 	return nil
 }
 
+func validatePortCallBudget(i interface{}) error {
+	entries, ok := i.([]UintMapEntry)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if entry.Key == "" {
+			return fmt.Errorf("port call budget must not have an empty port name")
+		}
+		if seen[entry.Key] {
+			return fmt.Errorf("port call budget has duplicate port name %q", entry.Key)
+		}
+		seen[entry.Key] = true
+		if entry.Value.IsNil() {
+			return fmt.Errorf("port call budget for %q must have a value", entry.Key)
+		}
+		if !entry.Value.BigInt().IsUint64() {
+			return fmt.Errorf("port call budget for %q must fit in a uint64, got %s", entry.Key, entry.Value)
+		}
+	}
+	return nil
+}
+
 func validateBundleUncompressedSizeLimitBytes(i interface{}) error {
 	if value, ok := i.(int64); !ok {
 		return fmt.Errorf("bundle_uncompressed_size_limit_bytes must be int64, got %#v", i)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"strings"
 	"reflect"
 	"testing"
@@ -213,6 +214,35 @@ func TestValidateParams(t *testing.T) {
 	}
 }
 
+func TestValidatePortCallBudget(t *testing.T) {
+	tooBig := sdkmath.NewUintFromBigInt(new(big.Int).Lsh(big.NewInt(1), 64))
+	for _, tc := range []struct {
+		name          string
+		budget        []UintMapEntry
+		wantErrSubstr string
+	}{
+		{name: "unlimited", budget: nil},
+		{name: "limited", budget: []UintMapEntry{{"vstorage", sdkmath.NewUint(10000)}, {"bank", sdkmath.ZeroUint()}}},
+		{name: "empty port name", budget: []UintMapEntry{{"", sdkmath.NewUint(1)}}, wantErrSubstr: "empty port name"},
+		{name: "duplicate port name", budget: []UintMapEntry{{"bank", sdkmath.NewUint(1)}, {"bank", sdkmath.NewUint(2)}}, wantErrSubstr: "duplicate"},
+		{name: "missing value", budget: []UintMapEntry{{Key: "bank"}}, wantErrSubstr: "must have a value"},
+		{name: "too big", budget: []UintMapEntry{{"bank", tooBig}}, wantErrSubstr: "uint64"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.PortCallBudget = tc.budget
+			err := params.ValidateBasic()
+			if tc.wantErrSubstr == "" {
+				if err != nil {
+					t.Errorf("unexpected ValidateBasic() error: %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tc.wantErrSubstr) {
+				t.Errorf("ValidateBasic() error = %v, want one containing %q", err, tc.wantErrSubstr)
+			}
+		})
+	}
+}
+
 func TestValidateInstallationDeadlineBounds(t *testing.T) {
 	const minInt64 int64 = -1 << 63
 
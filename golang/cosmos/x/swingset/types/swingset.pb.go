@@ -220,6 +220,15 @@ type Params struct {
 	BundleUncompressedSizeLimitBytes int64 `protobuf:"varint,9,opt,name=bundle_uncompressed_size_limit_bytes,json=bundleUncompressedSizeLimitBytes,proto3" json:"bundle_uncompressed_size_limit_bytes,omitempty"`
 	// The maximum size of a bundle or artifact chunk (0 implies default 490000 bytes)
 	ChunkSizeLimitBytes int64 `protobuf:"varint,10,opt,name=chunk_size_limit_bytes,json=chunkSizeLimitBytes,proto3" json:"chunk_size_limit_bytes,omitempty"`
+	// Port call budget values.
+	// Map from bridge port name (e.g., "vstorage") to the number of calls that
+	// the VM may make to that port within a single block.  Calls beyond the
+	// budget are rejected.  Ports that are not listed are unlimited.
+	//
+	// There is no required order to this list of entries, but all the chain
+	// nodes must all serialize and deserialize the existing order without
+	// permuting it.
+	PortCallBudget []UintMapEntry `protobuf:"bytes,11,rep,name=port_call_budget,json=portCallBudget,proto3" json:"port_call_budget"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -324,6 +333,13 @@ func (m *Params) GetChunkSizeLimitBytes() int64 {
 	return 0
 }
 
+func (m *Params) GetPortCallBudget() []UintMapEntry {
+	if m != nil {
+		return m.PortCallBudget
+	}
+	return nil
+}
+
 // The current state of the module.
 type State struct {
 	// The allowed number of items to add to queues, as determined by SwingSet.
@@ -960,104 +976,105 @@ func init() {
 func init() { proto.RegisterFile("agoric/swingset/swingset.proto", fileDescriptor_ff9c341e0de15f8b) }
 
 var fileDescriptor_ff9c341e0de15f8b = []byte{
-	// 1538 bytes of a gzipped FileDescriptorProto
+	// 1558 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xbd, 0x6f, 0x1b, 0xc9,
-	0x15, 0x27, 0x4d, 0x52, 0x16, 0x47, 0x94, 0x44, 0x8f, 0x64, 0x8b, 0x92, 0x6c, 0x8e, 0xb0, 0x49,
-	0xa1, 0xd8, 0x30, 0x69, 0xf9, 0x03, 0x01, 0x64, 0x04, 0xb1, 0x96, 0xa2, 0x22, 0xc1, 0xb6, 0x4c,
-	0x2f, 0x25, 0x17, 0x89, 0x8d, 0xc5, 0x70, 0x77, 0x48, 0xad, 0xb5, 0xdc, 0xa5, 0x77, 0x86, 0xb4,
-	0x64, 0xe4, 0x0f, 0x08, 0x52, 0x05, 0x29, 0x82, 0x94, 0xae, 0x53, 0xa5, 0xc8, 0x1f, 0x10, 0x20,
-	0x8d, 0x91, 0xca, 0xb8, 0xea, 0x70, 0x07, 0xec, 0x1d, 0xe4, 0xe2, 0x0e, 0xbc, 0x8e, 0xe5, 0x01,
-	0x07, 0x1c, 0xe6, 0x83, 0xe4, 0x5a, 0x24, 0x71, 0x6e, 0x0e, 0xd7, 0x48, 0xfb, 0xde, 0xef, 0xf7,
-	0xbe, 0x77, 0xde, 0x2c, 0x41, 0x1e, 0x37, 0xfc, 0xc0, 0xb1, 0x8a, 0xf4, 0xb5, 0xe3, 0x35, 0x28,
-	0x61, 0x83, 0x87, 0x42, 0x2b, 0xf0, 0x99, 0x0f, 0xe7, 0x25, 0x5e, 0xe8, 0xab, 0x57, 0x16, 0x1b,
-	0x7e, 0xc3, 0x17, 0x58, 0x91, 0x3f, 0x49, 0xda, 0x4a, 0xde, 0xf2, 0x69, 0xd3, 0xa7, 0xc5, 0x1a,
-	0xa6, 0xa4, 0xd8, 0xd9, 0xa8, 0x11, 0x86, 0x37, 0x8a, 0x96, 0xef, 0x78, 0x0a, 0x5f, 0x96, 0xb8,
-	0x29, 0x0d, 0xa5, 0xa0, 0xa0, 0x4b, 0xb8, 0xe9, 0x78, 0x7e, 0x51, 0xfc, 0x95, 0x2a, 0xed, 0x7f,
-	0x71, 0x90, 0x2d, 0xf9, 0x01, 0x29, 0x77, 0xb0, 0x5b, 0x09, 0xfc, 0x96, 0x4f, 0xb1, 0x0b, 0x17,
-	0x41, 0x8a, 0x39, 0xcc, 0x25, 0xb9, 0xf8, 0x5a, 0x7c, 0x3d, 0x6d, 0x48, 0x01, 0xae, 0x81, 0x19,
-	0x9b, 0x50, 0x2b, 0x70, 0x5a, 0xcc, 0xf1, 0xbd, 0xdc, 0x05, 0x81, 0x45, 0x55, 0xf0, 0x1e, 0x48,
-	0x91, 0x0e, 0x76, 0x69, 0x2e, 0xb1, 0x96, 0x58, 0x9f, 0xb9, 0xbd, 0x5c, 0x38, 0x57, 0x51, 0xa1,
-	0x1f, 0x49, 0x4f, 0xbe, 0x0b, 0x51, 0xcc, 0x90, 0xec, 0xcd, 0x07, 0x7f, 0x79, 0x8b, 0x62, 0xff,
-	0xff, 0xcf, 0xcd, 0x15, 0x95, 0x6c, 0xc3, 0xef, 0x14, 0x54, 0x61, 0x85, 0x92, 0xef, 0x31, 0xe2,
-	0xb1, 0xbf, 0x7e, 0xf3, 0xef, 0xeb, 0xcb, 0x83, 0xc6, 0x9d, 0x4f, 0x58, 0xa3, 0x60, 0xba, 0xaf,
-	0x83, 0x9b, 0x20, 0xf3, 0x92, 0xfa, 0x9e, 0xd9, 0x22, 0x41, 0xd3, 0x61, 0x54, 0xd6, 0xa0, 0x2f,
-	0xf5, 0x42, 0xb4, 0x70, 0x8a, 0x9b, 0xee, 0xa6, 0x16, 0x45, 0x35, 0x63, 0x86, 0x8b, 0x15, 0x29,
-	0xc1, 0x1b, 0xe0, 0xe2, 0x4b, 0x6a, 0x5a, 0xbe, 0x4d, 0x64, 0x79, 0x3a, 0xec, 0x85, 0x68, 0xae,
-	0x6f, 0x26, 0x00, 0xcd, 0x98, 0x7a, 0x49, 0x4b, 0xfc, 0xe1, 0xbb, 0x14, 0x98, 0xaa, 0xe0, 0x00,
-	0x37, 0x29, 0xdc, 0x05, 0x73, 0x35, 0x82, 0x3d, 0xca, 0xdd, 0x9a, 0x6d, 0xcf, 0x61, 0xb9, 0xb8,
-	0xe8, 0xc0, 0xd5, 0x91, 0x0e, 0x54, 0x59, 0xe0, 0x78, 0x0d, 0x9d, 0x93, 0x55, 0x13, 0x32, 0xc2,
-	0xb2, 0x42, 0x82, 0x43, 0xcf, 0x61, 0xf0, 0x15, 0x98, 0xab, 0x13, 0x22, 0x7c, 0x98, 0xad, 0xc0,
-	0xb1, 0x78, 0x22, 0xb2, 0x97, 0xaa, 0x39, 0x7c, 0xec, 0x91, 0xee, 0x38, 0x9e, 0x7e, 0x8b, 0xbb,
-	0xf9, 0xd7, 0x57, 0x68, 0xbd, 0xe1, 0xb0, 0xa3, 0x76, 0xad, 0x60, 0xf9, 0x4d, 0x35, 0x76, 0xf5,
-	0xef, 0x26, 0xb5, 0x8f, 0x8b, 0xec, 0xb4, 0x45, 0xa8, 0x30, 0xa0, 0x46, 0xa6, 0x4e, 0x08, 0x8f,
-	0x56, 0xe1, 0x01, 0xe0, 0x2d, 0xb0, 0x58, 0xf3, 0x7d, 0x46, 0x59, 0x80, 0x5b, 0x66, 0x07, 0x33,
-	0xd3, 0xf2, 0xbd, 0xba, 0xd3, 0xc8, 0x25, 0xc4, 0x80, 0xe1, 0x00, 0x7b, 0x86, 0x59, 0x49, 0x20,
-	0xf0, 0x21, 0x98, 0x6f, 0xf9, 0xaf, 0x49, 0x60, 0xd6, 0x5d, 0xdc, 0x30, 0xeb, 0x84, 0xd0, 0x5c,
-	0x52, 0x64, 0x79, 0x6d, 0xa4, 0xde, 0x0a, 0xe7, 0xed, 0xb8, 0xb8, 0xb1, 0x43, 0x88, 0x2a, 0x78,
-	0xb6, 0x15, 0xd1, 0x51, 0xf8, 0x3b, 0x90, 0x7e, 0xd5, 0x26, 0x6d, 0x62, 0x36, 0xf1, 0x49, 0x2e,
-	0x25, 0xdc, 0xac, 0x8c, 0xb8, 0x79, 0xca, 0x19, 0x55, 0xe7, 0x4d, 0xdf, 0xc7, 0xb4, 0x30, 0x79,
-	0x8c, 0x4f, 0xe0, 0x53, 0x00, 0x45, 0xce, 0x2e, 0xc1, 0x5e, 0xbb, 0x65, 0xd6, 0xda, 0x76, 0x83,
-	0xb0, 0xdc, 0xd4, 0x84, 0x74, 0x0e, 0x1d, 0x8f, 0x3d, 0xc6, 0xad, 0xb2, 0xc7, 0x82, 0x53, 0xe5,
-	0x2a, 0xdb, 0xc1, 0xac, 0x24, 0xad, 0x75, 0x61, 0x0c, 0x1f, 0x80, 0xab, 0x8e, 0x47, 0x19, 0x76,
-	0x5d, 0xcc, 0x5f, 0x6b, 0xd3, 0x26, 0xd8, 0x76, 0x1d, 0x8f, 0x98, 0x35, 0xd7, 0xb7, 0x8e, 0x69,
-	0xee, 0xe2, 0x5a, 0x7c, 0x3d, 0x61, 0xac, 0x44, 0x39, 0xdb, 0x8a, 0xa2, 0x0b, 0x06, 0xd4, 0xc1,
-	0xb5, 0xf1, 0x1e, 0x28, 0xb1, 0x7c, 0xcf, 0xa6, 0xb9, 0x69, 0xe1, 0x62, 0x75, 0x9c, 0x8b, 0xaa,
-	0xa4, 0xc0, 0x7d, 0xf0, 0xeb, 0x5a, 0xdb, 0xb3, 0x5d, 0xfe, 0x32, 0x58, 0x7e, 0xb3, 0x15, 0x10,
-	0x4a, 0x89, 0x6d, 0x52, 0xe7, 0x0d, 0x31, 0x5d, 0xa7, 0xe9, 0x30, 0xb3, 0x76, 0xca, 0x08, 0xcd,
-	0xa5, 0x85, 0xab, 0x35, 0xc9, 0x3d, 0x8c, 0x50, 0x79, 0xbb, 0x1e, 0x71, 0xa2, 0xce, 0x79, 0xf0,
-	0x0e, 0xb8, 0x62, 0x1d, 0xb5, 0xbd, 0xe3, 0x51, 0x0f, 0x40, 0x78, 0x58, 0x10, 0xe8, 0xc7, 0x46,
-	0x9b, 0xd3, 0xff, 0x7c, 0x8b, 0x62, 0xdf, 0xbe, 0x45, 0x71, 0xed, 0xbf, 0x09, 0x90, 0xaa, 0x32,
-	0xcc, 0x08, 0x2c, 0x83, 0x59, 0x39, 0x30, 0xec, 0xba, 0xfe, 0x6b, 0x62, 0xab, 0x77, 0xfd, 0xa7,
-	0x87, 0x96, 0x11, 0x66, 0x5b, 0xd2, 0x0a, 0xfe, 0x19, 0x2c, 0xd7, 0x9d, 0x80, 0x32, 0x53, 0xc4,
-	0x25, 0xb6, 0x89, 0x03, 0xe6, 0xd4, 0xb1, 0xc5, 0x4c, 0xc7, 0x16, 0xa7, 0x2f, 0xa9, 0x6f, 0x75,
-	0x43, 0x34, 0x99, 0xd4, 0x0b, 0xd1, 0x9a, 0x3c, 0x9a, 0x13, 0x29, 0x9a, 0x71, 0x45, 0x60, 0x25,
-	0x09, 0x6d, 0x29, 0x64, 0xcf, 0x86, 0x27, 0x20, 0xe7, 0xe2, 0x09, 0xc1, 0x13, 0x22, 0xf8, 0xef,
-	0xbb, 0x21, 0x9a, 0xc8, 0xe9, 0x85, 0x08, 0xc9, 0xd8, 0x93, 0x18, 0x9a, 0x71, 0x99, 0x43, 0x63,
-	0x23, 0x7b, 0xe4, 0x64, 0x7c, 0xe4, 0xe4, 0x30, 0xf2, 0x24, 0xce, 0x30, 0xf2, 0x24, 0x86, 0x66,
-	0x5c, 0xe6, 0xd0, 0x48, 0x64, 0xed, 0x4f, 0x60, 0x26, 0xb2, 0x7e, 0x60, 0x16, 0x24, 0x8e, 0xc9,
-	0xa9, 0xda, 0xf1, 0xfc, 0x11, 0xde, 0x05, 0x29, 0xb1, 0x8c, 0xd4, 0xf2, 0xcb, 0xf3, 0xa9, 0x7d,
-	0x11, 0xa2, 0x2b, 0x72, 0x8d, 0x50, 0xfb, 0xb8, 0xe0, 0xf8, 0xc5, 0x26, 0x66, 0x47, 0xe2, 0x1c,
-	0x19, 0x92, 0xbc, 0x99, 0x14, 0xef, 0xc7, 0xdf, 0xe3, 0x20, 0x13, 0x3d, 0xec, 0xf0, 0x1a, 0x00,
-	0xc3, 0x25, 0xa1, 0xa2, 0xa4, 0x07, 0x47, 0x1f, 0xbe, 0x00, 0x89, 0x3a, 0xf9, 0x59, 0xb6, 0x1b,
-	0xf7, 0xab, 0x92, 0xfa, 0x2d, 0x48, 0x0f, 0x5e, 0xc2, 0x31, 0xf5, 0x42, 0x90, 0xe4, 0x87, 0x41,
-	0x94, 0x9b, 0x32, 0xc4, 0xb3, 0x32, 0x7c, 0x0e, 0x32, 0xd1, 0x55, 0x31, 0xbe, 0x57, 0x1d, 0xec,
-	0xb6, 0xc9, 0xa7, 0xf6, 0x4a, 0x90, 0x95, 0xf7, 0x1f, 0xe2, 0x60, 0xaa, 0xdc, 0xe0, 0xa7, 0x14,
-	0xde, 0x07, 0xd3, 0x9e, 0x63, 0x1d, 0x7b, 0xb8, 0xa9, 0x6e, 0x5b, 0x1d, 0x75, 0x43, 0x34, 0xd0,
-	0xf5, 0x42, 0x34, 0xaf, 0xa6, 0xad, 0x34, 0x9a, 0x31, 0x00, 0xe1, 0x73, 0x90, 0x6c, 0x11, 0x12,
-	0x88, 0x14, 0x32, 0xfa, 0x6e, 0x37, 0x44, 0x42, 0xee, 0x85, 0x68, 0x46, 0x1a, 0x71, 0x49, 0xfb,
-	0x3e, 0x44, 0x37, 0x3f, 0xa1, 0x79, 0x5b, 0x96, 0xb5, 0x65, 0xdb, 0x3c, 0x29, 0x43, 0x78, 0x81,
-	0x06, 0x98, 0x19, 0x0e, 0x50, 0xde, 0xe9, 0x69, 0x7d, 0xe3, 0x2c, 0x44, 0x60, 0x30, 0x67, 0xda,
-	0x0d, 0x11, 0x18, 0xcc, 0x94, 0xf6, 0x42, 0x74, 0x49, 0x05, 0x1e, 0xe8, 0x34, 0x23, 0x42, 0x10,
-	0xf5, 0xc7, 0x34, 0x06, 0x60, 0x95, 0x2f, 0x89, 0x2a, 0xf3, 0x03, 0xd2, 0x7f, 0x41, 0xe1, 0x0d,
-	0x90, 0x8c, 0xb4, 0x61, 0x89, 0x57, 0xa3, 0x5a, 0xa0, 0xaa, 0x91, 0xe5, 0x0b, 0x25, 0x27, 0xdb,
-	0x98, 0x61, 0x55, 0xba, 0x20, 0x73, 0x79, 0x48, 0xe6, 0x92, 0x66, 0x08, 0xa5, 0x8a, 0xfa, 0x65,
-	0x1c, 0xcc, 0x9f, 0x3b, 0x14, 0xf0, 0x0e, 0x98, 0xa2, 0x47, 0xf8, 0xde, 0xc6, 0x6d, 0x15, 0x75,
-	0xb5, 0x1b, 0x22, 0xa5, 0xe9, 0x85, 0x68, 0x56, 0xba, 0x92, 0xb2, 0x66, 0x28, 0x00, 0xea, 0x00,
-	0x88, 0x1d, 0x2a, 0xb7, 0xa7, 0x5c, 0x55, 0xbf, 0xe2, 0x9d, 0x18, 0x6a, 0x87, 0x9d, 0x18, 0xea,
-	0x34, 0x23, 0xcd, 0x05, 0xb9, 0x8d, 0x9f, 0x80, 0x29, 0x71, 0x74, 0xfb, 0xdf, 0x4a, 0xa3, 0xdb,
-	0x53, 0xa4, 0xba, 0xe7, 0xd5, 0x7d, 0x99, 0x94, 0x64, 0x0f, 0x93, 0x92, 0xb2, 0x66, 0x28, 0x40,
-	0xfb, 0x2c, 0x0e, 0xd2, 0x03, 0x93, 0x5f, 0xae, 0xae, 0x47, 0x20, 0x45, 0xf9, 0x2d, 0x21, 0x96,
-	0xe8, 0xdc, 0xed, 0xd5, 0xf1, 0x65, 0x89, 0x8b, 0x44, 0x5f, 0xee, 0x86, 0x48, 0xb2, 0x7b, 0x21,
-	0xca, 0x28, 0xb7, 0x5c, 0xd4, 0x0c, 0xa9, 0xd6, 0xfe, 0x91, 0x00, 0x0b, 0xe7, 0x46, 0xb6, 0xef,
-	0xdb, 0x04, 0x62, 0xb0, 0x30, 0x6e, 0x7d, 0xc6, 0x45, 0xca, 0x1b, 0xdd, 0x10, 0x5d, 0xb2, 0xce,
-	0x6f, 0xbf, 0x5e, 0x88, 0x72, 0x91, 0xce, 0x7d, 0xb4, 0x18, 0x8d, 0x51, 0x3a, 0xbc, 0x0b, 0x2e,
-	0x8a, 0x05, 0x3b, 0xb8, 0x8c, 0x44, 0x0b, 0xb9, 0x4a, 0xf8, 0x9a, 0x1d, 0xee, 0x60, 0xee, 0x40,
-	0x01, 0xdc, 0xaa, 0x15, 0x90, 0xce, 0xf0, 0x16, 0x11, 0x56, 0x5c, 0x15, 0xb5, 0x92, 0xb2, 0x66,
-	0x28, 0x00, 0x3e, 0x05, 0xf3, 0x94, 0xe1, 0x80, 0x99, 0xcc, 0x69, 0x8a, 0x6f, 0xbf, 0x13, 0x71,
-	0x13, 0x24, 0xf4, 0xdf, 0x74, 0x43, 0x34, 0x2b, 0xa0, 0x03, 0xa7, 0xc9, 0x3f, 0xd9, 0x4e, 0x7a,
-	0x21, 0x5a, 0x1c, 0x74, 0x6a, 0xa8, 0xd6, 0x8c, 0x8f, 0x69, 0xf0, 0x05, 0x80, 0xd2, 0xa5, 0xf8,
-	0x66, 0x31, 0x8f, 0x88, 0xd3, 0x38, 0x62, 0xb9, 0x94, 0xf0, 0x5a, 0xec, 0x86, 0x28, 0x2b, 0x50,
-	0xf1, 0xb9, 0xb2, 0x2b, 0xb0, 0x5e, 0x88, 0x96, 0x22, 0x8e, 0x23, 0x88, 0x66, 0x8c, 0x90, 0xaf,
-	0x9f, 0x02, 0x30, 0x1c, 0x24, 0x5c, 0x05, 0x4b, 0xa5, 0xdd, 0xc3, 0xfd, 0x87, 0x66, 0xf5, 0x60,
-	0xeb, 0xa0, 0x6c, 0x1e, 0xee, 0x57, 0x2b, 0xe5, 0xd2, 0xde, 0xce, 0x5e, 0x79, 0x3b, 0x1b, 0x83,
-	0xcb, 0xe0, 0x72, 0x14, 0xdc, 0xdb, 0x37, 0x77, 0x1e, 0xed, 0xfd, 0x61, 0xf7, 0x20, 0x1b, 0x87,
-	0x39, 0xb0, 0x18, 0x85, 0x8c, 0x72, 0xa9, 0xbc, 0xf7, 0xac, 0xbc, 0x9d, 0xbd, 0x70, 0xde, 0xa8,
-	0x62, 0x3c, 0x29, 0x95, 0xab, 0xd5, 0xf2, 0x76, 0x36, 0xa1, 0x1f, 0xbe, 0x3b, 0xcb, 0xc7, 0xdf,
-	0x9f, 0xe5, 0xe3, 0x5f, 0x9f, 0xe5, 0xe3, 0x7f, 0xfb, 0x90, 0x8f, 0xbd, 0xff, 0x90, 0x8f, 0x7d,
-	0xfe, 0x21, 0x1f, 0xfb, 0xe3, 0xfd, 0xc8, 0x96, 0xdb, 0x92, 0xbf, 0xb5, 0xe4, 0xdb, 0x27, 0xb6,
-	0x5c, 0xc3, 0x77, 0xb1, 0xd7, 0xe8, 0xaf, 0xbf, 0x93, 0xe1, 0xcf, 0x30, 0xb1, 0xfe, 0x6a, 0x53,
-	0xe2, 0xf7, 0xd0, 0x9d, 0x1f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x64, 0x2a, 0x7d, 0x97, 0xa6, 0x0d,
-	0x00, 0x00,
+	0x15, 0xe7, 0x1e, 0x3f, 0x2c, 0x8e, 0x68, 0x89, 0x1e, 0xcb, 0xf6, 0x4a, 0x3e, 0x73, 0x84, 0x4d,
+	0x0a, 0xe7, 0x0e, 0x26, 0x4f, 0xf6, 0x1d, 0x02, 0xe8, 0x10, 0xe4, 0xb4, 0x34, 0x15, 0x11, 0x67,
+	0xeb, 0xe8, 0xa5, 0x74, 0x45, 0x72, 0x87, 0xc5, 0x70, 0x77, 0x48, 0xad, 0xb5, 0xdc, 0xe1, 0xed,
+	0x0c, 0x69, 0xe9, 0x90, 0x3f, 0x20, 0x48, 0x15, 0xa4, 0x08, 0x52, 0xba, 0x4e, 0x95, 0x22, 0x55,
+	0xaa, 0x00, 0x69, 0x0e, 0xa9, 0x8c, 0x54, 0x41, 0x02, 0x6c, 0x02, 0xb9, 0x48, 0xc0, 0x92, 0x65,
+	0x80, 0x00, 0xc1, 0x7c, 0x90, 0xdc, 0x88, 0x22, 0xa0, 0x26, 0xb8, 0x46, 0xda, 0xf7, 0x7e, 0xbf,
+	0xf7, 0x39, 0x33, 0x6f, 0x86, 0xa0, 0x82, 0x7b, 0x34, 0x0e, 0xbc, 0x1a, 0x7b, 0x15, 0x44, 0x3d,
+	0x46, 0xf8, 0xec, 0xa3, 0x3a, 0x88, 0x29, 0xa7, 0x70, 0x5d, 0xe1, 0xd5, 0xa9, 0x7a, 0x6b, 0xa3,
+	0x47, 0x7b, 0x54, 0x62, 0x35, 0xf1, 0xa5, 0x68, 0x5b, 0x15, 0x8f, 0xb2, 0x3e, 0x65, 0xb5, 0x0e,
+	0x66, 0xa4, 0x36, 0xda, 0xe9, 0x10, 0x8e, 0x77, 0x6a, 0x1e, 0x0d, 0x22, 0x8d, 0x6f, 0x2a, 0xdc,
+	0x55, 0x86, 0x4a, 0xd0, 0xd0, 0x2d, 0xdc, 0x0f, 0x22, 0x5a, 0x93, 0x7f, 0x95, 0xca, 0xfa, 0xa3,
+	0x01, 0xca, 0x75, 0x1a, 0x93, 0xc6, 0x08, 0x87, 0xad, 0x98, 0x0e, 0x28, 0xc3, 0x21, 0xdc, 0x00,
+	0x79, 0x1e, 0xf0, 0x90, 0x98, 0xc6, 0xb6, 0xf1, 0xb0, 0xe8, 0x28, 0x01, 0x6e, 0x83, 0x55, 0x9f,
+	0x30, 0x2f, 0x0e, 0x06, 0x3c, 0xa0, 0x91, 0xf9, 0x8e, 0xc4, 0xd2, 0x2a, 0xf8, 0x11, 0xc8, 0x93,
+	0x11, 0x0e, 0x99, 0x99, 0xdd, 0xce, 0x3e, 0x5c, 0x7d, 0xbc, 0x59, 0xbd, 0x54, 0x51, 0x75, 0x1a,
+	0xc9, 0xce, 0x7d, 0x93, 0xa0, 0x8c, 0xa3, 0xd8, 0xbb, 0x9f, 0xfc, 0xec, 0x35, 0xca, 0xfc, 0xe9,
+	0x77, 0x8f, 0xb6, 0x74, 0xb2, 0x3d, 0x3a, 0xaa, 0xea, 0xc2, 0xaa, 0x75, 0x1a, 0x71, 0x12, 0xf1,
+	0x9f, 0xff, 0xf3, 0xb7, 0xef, 0x6d, 0xce, 0x1a, 0x77, 0x39, 0x61, 0x8b, 0x81, 0x95, 0xa9, 0x0e,
+	0xee, 0x82, 0xd2, 0x4b, 0x46, 0x23, 0x77, 0x40, 0xe2, 0x7e, 0xc0, 0x99, 0xaa, 0xc1, 0xbe, 0x37,
+	0x49, 0xd0, 0xed, 0x73, 0xdc, 0x0f, 0x77, 0xad, 0x34, 0x6a, 0x39, 0xab, 0x42, 0x6c, 0x29, 0x09,
+	0xbe, 0x0f, 0x6e, 0xbc, 0x64, 0xae, 0x47, 0x7d, 0xa2, 0xca, 0xb3, 0xe1, 0x24, 0x41, 0x6b, 0x53,
+	0x33, 0x09, 0x58, 0x4e, 0xe1, 0x25, 0xab, 0x8b, 0x8f, 0xdf, 0x17, 0x40, 0xa1, 0x85, 0x63, 0xdc,
+	0x67, 0xf0, 0x00, 0xac, 0x75, 0x08, 0x8e, 0x98, 0x70, 0xeb, 0x0e, 0xa3, 0x80, 0x9b, 0x86, 0xec,
+	0xc0, 0xbb, 0x0b, 0x1d, 0x68, 0xf3, 0x38, 0x88, 0x7a, 0xb6, 0x20, 0xeb, 0x26, 0x94, 0xa4, 0x65,
+	0x8b, 0xc4, 0xc7, 0x51, 0xc0, 0xe1, 0x57, 0x60, 0xad, 0x4b, 0x88, 0xf4, 0xe1, 0x0e, 0xe2, 0xc0,
+	0x13, 0x89, 0xa8, 0x5e, 0xea, 0xe6, 0x88, 0x65, 0x4f, 0x75, 0x27, 0x88, 0xec, 0x0f, 0x84, 0x9b,
+	0xdf, 0xfc, 0x1d, 0x3d, 0xec, 0x05, 0xfc, 0x64, 0xd8, 0xa9, 0x7a, 0xb4, 0xaf, 0x97, 0x5d, 0xff,
+	0x7b, 0xc4, 0xfc, 0xd3, 0x1a, 0x3f, 0x1f, 0x10, 0x26, 0x0d, 0x98, 0x53, 0xea, 0x12, 0x22, 0xa2,
+	0xb5, 0x44, 0x00, 0xf8, 0x01, 0xd8, 0xe8, 0x50, 0xca, 0x19, 0x8f, 0xf1, 0xc0, 0x1d, 0x61, 0xee,
+	0x7a, 0x34, 0xea, 0x06, 0x3d, 0x33, 0x2b, 0x17, 0x18, 0xce, 0xb0, 0xcf, 0x31, 0xaf, 0x4b, 0x04,
+	0x7e, 0x0a, 0xd6, 0x07, 0xf4, 0x15, 0x89, 0xdd, 0x6e, 0x88, 0x7b, 0x6e, 0x97, 0x10, 0x66, 0xe6,
+	0x64, 0x96, 0x0f, 0x16, 0xea, 0x6d, 0x09, 0xde, 0x7e, 0x88, 0x7b, 0xfb, 0x84, 0xe8, 0x82, 0x6f,
+	0x0e, 0x52, 0x3a, 0x06, 0x7f, 0x00, 0x8a, 0x5f, 0x0d, 0xc9, 0x90, 0xb8, 0x7d, 0x7c, 0x66, 0xe6,
+	0xa5, 0x9b, 0xad, 0x05, 0x37, 0x2f, 0x04, 0xa3, 0x1d, 0x7c, 0x3d, 0xf5, 0xb1, 0x22, 0x4d, 0x9e,
+	0xe3, 0x33, 0xf8, 0x02, 0x40, 0x99, 0x73, 0x48, 0x70, 0x34, 0x1c, 0xb8, 0x9d, 0xa1, 0xdf, 0x23,
+	0xdc, 0x2c, 0x2c, 0x49, 0xe7, 0x38, 0x88, 0xf8, 0x73, 0x3c, 0x68, 0x44, 0x3c, 0x3e, 0xd7, 0xae,
+	0xca, 0x23, 0xcc, 0xeb, 0xca, 0xda, 0x96, 0xc6, 0xf0, 0x13, 0xf0, 0x6e, 0x10, 0x31, 0x8e, 0xc3,
+	0x10, 0x8b, 0x6d, 0xed, 0xfa, 0x04, 0xfb, 0x61, 0x10, 0x11, 0xb7, 0x13, 0x52, 0xef, 0x94, 0x99,
+	0x37, 0xb6, 0x8d, 0x87, 0x59, 0x67, 0x2b, 0xcd, 0x79, 0xaa, 0x29, 0xb6, 0x64, 0x40, 0x1b, 0x3c,
+	0xb8, 0xda, 0x03, 0x23, 0x1e, 0x8d, 0x7c, 0x66, 0xae, 0x48, 0x17, 0xf7, 0xaf, 0x72, 0xd1, 0x56,
+	0x14, 0x78, 0x08, 0xbe, 0xdb, 0x19, 0x46, 0x7e, 0x28, 0x36, 0x83, 0x47, 0xfb, 0x83, 0x98, 0x30,
+	0x46, 0x7c, 0x97, 0x05, 0x5f, 0x13, 0x37, 0x0c, 0xfa, 0x01, 0x77, 0x3b, 0xe7, 0x9c, 0x30, 0xb3,
+	0x28, 0x5d, 0x6d, 0x2b, 0xee, 0x71, 0x8a, 0x2a, 0xda, 0xf5, 0x4c, 0x10, 0x6d, 0xc1, 0x83, 0x4f,
+	0xc0, 0x5d, 0xef, 0x64, 0x18, 0x9d, 0x2e, 0x7a, 0x00, 0xd2, 0xc3, 0x6d, 0x89, 0x5e, 0x32, 0x7a,
+	0x0e, 0xca, 0x03, 0x1a, 0x73, 0xd7, 0xc3, 0x61, 0x38, 0xed, 0xed, 0xea, 0xf5, 0x7b, 0xbb, 0x26,
+	0x8c, 0xeb, 0x38, 0x0c, 0x55, 0x67, 0x77, 0x57, 0x7e, 0xfd, 0x1a, 0x65, 0xfe, 0xf5, 0x1a, 0x19,
+	0xd6, 0x1f, 0xb2, 0x20, 0xdf, 0xe6, 0x98, 0x13, 0xd8, 0x00, 0x37, 0xd5, 0xfa, 0xe3, 0x30, 0xa4,
+	0xaf, 0x88, 0x6f, 0x1a, 0xd7, 0xdc, 0x03, 0x25, 0x69, 0xb6, 0xa7, 0xac, 0xe0, 0x4f, 0xc1, 0x66,
+	0x37, 0x88, 0x19, 0x77, 0x65, 0x19, 0xc4, 0x77, 0x71, 0xcc, 0x83, 0x2e, 0xf6, 0xb8, 0x1b, 0xf8,
+	0xf2, 0x30, 0xe7, 0xec, 0xbd, 0x71, 0x82, 0x96, 0x93, 0x26, 0x09, 0xda, 0x56, 0x27, 0x7d, 0x29,
+	0xc5, 0x72, 0xee, 0x4a, 0xac, 0xae, 0xa0, 0x3d, 0x8d, 0x34, 0x7d, 0x78, 0x06, 0xcc, 0x10, 0x2f,
+	0x09, 0x9e, 0x95, 0xc1, 0x7f, 0x38, 0x4e, 0xd0, 0x52, 0xce, 0x24, 0x41, 0x48, 0xc5, 0x5e, 0xc6,
+	0xb0, 0x9c, 0x3b, 0x21, 0x5e, 0x12, 0x39, 0x22, 0x67, 0x57, 0x47, 0xce, 0xcd, 0x23, 0x2f, 0xe3,
+	0xcc, 0x23, 0x2f, 0x63, 0x58, 0xce, 0x1d, 0x01, 0x2d, 0x44, 0xb6, 0x7e, 0x02, 0x56, 0x53, 0xd3,
+	0x0c, 0x96, 0x41, 0xf6, 0x94, 0x9c, 0xeb, 0x2b, 0x43, 0x7c, 0xc2, 0x0f, 0x41, 0x5e, 0xce, 0x36,
+	0x3d, 0x4b, 0x2b, 0x62, 0xd5, 0xfe, 0x9a, 0xa0, 0xbb, 0x6a, 0x2a, 0x31, 0xff, 0xb4, 0x1a, 0xd0,
+	0x5a, 0x1f, 0xf3, 0x13, 0xb9, 0x75, 0x1c, 0x45, 0xde, 0xcd, 0xc9, 0xfd, 0xf1, 0x4b, 0x03, 0x94,
+	0xd2, 0xb3, 0x03, 0x3e, 0x00, 0x60, 0x3e, 0x73, 0x74, 0x94, 0xe2, 0x6c, 0x92, 0xc0, 0x2f, 0x41,
+	0xb6, 0x4b, 0xfe, 0x2f, 0xc3, 0x52, 0xf8, 0xd5, 0x49, 0x7d, 0x1f, 0x14, 0x67, 0x9b, 0xf0, 0x8a,
+	0x7a, 0x21, 0xc8, 0x89, 0xb3, 0x25, 0xcb, 0xcd, 0x3b, 0xf2, 0x5b, 0x1b, 0x7e, 0x01, 0x4a, 0xe9,
+	0xd3, 0x71, 0x75, 0xaf, 0x46, 0x38, 0x1c, 0x92, 0xeb, 0xf6, 0x4a, 0x92, 0xb5, 0xf7, 0xff, 0x18,
+	0xa0, 0xd0, 0xe8, 0x89, 0x43, 0x0f, 0x3f, 0x06, 0x2b, 0x51, 0xe0, 0x9d, 0x46, 0xb8, 0xaf, 0x2f,
+	0x6f, 0x1b, 0x8d, 0x13, 0x34, 0xd3, 0x4d, 0x12, 0xb4, 0xae, 0x57, 0x5b, 0x6b, 0x2c, 0x67, 0x06,
+	0xc2, 0x2f, 0x40, 0x6e, 0x40, 0x48, 0x2c, 0x53, 0x28, 0xd9, 0x07, 0xe3, 0x04, 0x49, 0x79, 0x92,
+	0xa0, 0x55, 0x65, 0x24, 0x24, 0xeb, 0xdf, 0x09, 0x7a, 0x74, 0x8d, 0xe6, 0xed, 0x79, 0xde, 0x9e,
+	0xef, 0x8b, 0xa4, 0x1c, 0xe9, 0x05, 0x3a, 0x60, 0x75, 0xbe, 0x80, 0xea, 0x89, 0x50, 0xb4, 0x77,
+	0x2e, 0x12, 0x04, 0x66, 0xeb, 0xcc, 0xc6, 0x09, 0x02, 0xb3, 0x35, 0x65, 0x93, 0x04, 0xdd, 0xd2,
+	0x81, 0x67, 0x3a, 0xcb, 0x49, 0x11, 0x64, 0xfd, 0x19, 0x8b, 0x03, 0xd8, 0x16, 0x43, 0xa2, 0xcd,
+	0x69, 0x4c, 0xa6, 0x1b, 0x14, 0xbe, 0x0f, 0x72, 0xa9, 0x36, 0xdc, 0x13, 0xd5, 0xe8, 0x16, 0xe8,
+	0x6a, 0x54, 0xf9, 0x52, 0x29, 0xc8, 0x3e, 0xe6, 0x58, 0x97, 0x2e, 0xc9, 0x42, 0x9e, 0x93, 0x85,
+	0x64, 0x39, 0x52, 0xa9, 0xa3, 0xfe, 0xcd, 0x00, 0xeb, 0x97, 0x0e, 0x05, 0x7c, 0x02, 0x0a, 0xec,
+	0x04, 0x7f, 0xb4, 0xf3, 0x58, 0x47, 0xbd, 0x3f, 0x4e, 0x90, 0xd6, 0x4c, 0x12, 0x74, 0x53, 0xb9,
+	0x52, 0xb2, 0xe5, 0x68, 0x00, 0xda, 0x00, 0xc8, 0x91, 0xac, 0x86, 0xb1, 0x1a, 0x55, 0xdf, 0x11,
+	0x9d, 0x98, 0x6b, 0xe7, 0x9d, 0x98, 0xeb, 0x2c, 0xa7, 0x28, 0x04, 0x35, 0xa7, 0x3f, 0x03, 0x05,
+	0x79, 0x74, 0xa7, 0x4f, 0xaf, 0xc5, 0xe9, 0x29, 0x53, 0x6d, 0x46, 0x5d, 0xaa, 0x92, 0x52, 0xec,
+	0x79, 0x52, 0x4a, 0xb6, 0x1c, 0x0d, 0x58, 0x7f, 0x36, 0x40, 0x71, 0x66, 0xf2, 0xed, 0xd5, 0xf5,
+	0x0c, 0xe4, 0x19, 0xc7, 0x9c, 0xc8, 0x21, 0xba, 0xf6, 0xf8, 0xfe, 0xd5, 0x65, 0xc9, 0x8b, 0xc4,
+	0xde, 0x1c, 0x27, 0x48, 0xb1, 0x27, 0x09, 0x2a, 0x69, 0xb7, 0x42, 0xb4, 0x1c, 0xa5, 0xb6, 0x7e,
+	0x95, 0x05, 0xb7, 0x2f, 0x2d, 0xd9, 0x21, 0xf5, 0x09, 0xc4, 0xe0, 0xf6, 0x55, 0xe3, 0xd3, 0x90,
+	0x29, 0xef, 0x8c, 0x13, 0x74, 0xcb, 0xbb, 0x3c, 0xfd, 0x26, 0x09, 0x32, 0x53, 0x9d, 0x4b, 0x43,
+	0x96, 0xb3, 0x48, 0x87, 0x1f, 0x82, 0x1b, 0x72, 0xc0, 0xce, 0x2e, 0x23, 0xd9, 0x42, 0xa1, 0x6a,
+	0xfa, 0xf3, 0x16, 0x2a, 0xd9, 0x72, 0x34, 0x20, 0xac, 0x06, 0x31, 0x19, 0xcd, 0x6f, 0x11, 0x69,
+	0x25, 0x54, 0x69, 0x2b, 0x25, 0x5b, 0x8e, 0x06, 0xe0, 0x0b, 0xb0, 0xce, 0x38, 0x8e, 0xb9, 0xcb,
+	0x83, 0xbe, 0x7c, 0x4a, 0x9e, 0xc9, 0x9b, 0x20, 0x6b, 0x7f, 0x6f, 0x9c, 0xa0, 0x9b, 0x12, 0x3a,
+	0x0a, 0xfa, 0xe2, 0x05, 0x78, 0x36, 0x49, 0xd0, 0xc6, 0xac, 0x53, 0x73, 0xb5, 0xe5, 0xfc, 0x2f,
+	0x0d, 0x7e, 0x09, 0xa0, 0x72, 0x29, 0x9f, 0x40, 0xee, 0x09, 0x09, 0x7a, 0x27, 0xdc, 0xcc, 0x4b,
+	0xaf, 0xb5, 0x71, 0x82, 0xca, 0x12, 0x95, 0xaf, 0x9f, 0x03, 0x89, 0x4d, 0x12, 0x74, 0x2f, 0xe5,
+	0x38, 0x85, 0x58, 0xce, 0x02, 0xf9, 0xbd, 0x73, 0x00, 0xe6, 0x0b, 0x09, 0xef, 0x83, 0x7b, 0xf5,
+	0x83, 0xe3, 0xc3, 0x4f, 0xdd, 0xf6, 0xd1, 0xde, 0x51, 0xc3, 0x3d, 0x3e, 0x6c, 0xb7, 0x1a, 0xf5,
+	0xe6, 0x7e, 0xb3, 0xf1, 0xb4, 0x9c, 0x81, 0x9b, 0xe0, 0x4e, 0x1a, 0x6c, 0x1e, 0xba, 0xfb, 0xcf,
+	0x9a, 0x3f, 0x3a, 0x38, 0x2a, 0x1b, 0xd0, 0x04, 0x1b, 0x69, 0xc8, 0x69, 0xd4, 0x1b, 0xcd, 0xcf,
+	0x1b, 0x4f, 0xcb, 0xef, 0x5c, 0x36, 0x6a, 0x39, 0x9f, 0xd5, 0x1b, 0xed, 0x76, 0xe3, 0x69, 0x39,
+	0x6b, 0x1f, 0x7f, 0x73, 0x51, 0x31, 0xde, 0x5c, 0x54, 0x8c, 0x7f, 0x5c, 0x54, 0x8c, 0x5f, 0xbc,
+	0xad, 0x64, 0xde, 0xbc, 0xad, 0x64, 0xfe, 0xf2, 0xb6, 0x92, 0xf9, 0xf1, 0xc7, 0xa9, 0x29, 0xb7,
+	0xa7, 0x7e, 0xba, 0xa9, 0xdd, 0x27, 0xa7, 0x5c, 0x8f, 0x86, 0x38, 0xea, 0x4d, 0xc7, 0xdf, 0xd9,
+	0xfc, 0x57, 0x9d, 0x1c, 0x7f, 0x9d, 0x82, 0xfc, 0x79, 0xf5, 0xe4, 0xbf, 0x03, 0x00, 0xd9, 0x40,
+	0x11, 0x70, 0xf5, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1134,6 +1151,14 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ChunkSizeLimitBytes != that1.ChunkSizeLimitBytes {
 		return false
 	}
+	if len(this.PortCallBudget) != len(that1.PortCallBudget) {
+		return false
+	}
+	for i := range this.PortCallBudget {
+		if !this.PortCallBudget[i].Equal(&that1.PortCallBudget[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *StringBeans) Equal(that interface{}) bool {
@@ -1357,6 +1382,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PortCallBudget) > 0 {
+		for iNdEx := len(m.PortCallBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PortCallBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwingset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.ChunkSizeLimitBytes != 0 {
 		i = encodeVarintSwingset(dAtA, i, uint64(m.ChunkSizeLimitBytes))
 		i--
@@ -1991,6 +2030,12 @@ func (m *Params) Size() (n int) {
 	if m.ChunkSizeLimitBytes != 0 {
 		n += 1 + sovSwingset(uint64(m.ChunkSizeLimitBytes))
 	}
+	if len(m.PortCallBudget) > 0 {
+		for _, e := range m.PortCallBudget {
+			l = e.Size()
+			n += 1 + l + sovSwingset(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2763,6 +2808,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortCallBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwingset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwingset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortCallBudget = append(m.PortCallBudget, UintMapEntry{})
+			if err := m.PortCallBudget[len(m.PortCallBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwingset(dAtA[iNdEx:])
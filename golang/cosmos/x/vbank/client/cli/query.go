@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
@@ -21,6 +23,12 @@ func GetQueryCmd() *cobra.Command {
 	vbankQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryState(),
+		GetCmdQueryHolds(),
+		GetCmdQueryWatches(),
+		GetCmdQueryRewardHistory(),
+		GetCmdQueryProjectedRewards(),
+		GetCmdQueryPoolFlows(),
+		GetCmdQueryBalancesAt(),
 	)
 
 	return vbankQueryCmd
@@ -77,3 +85,183 @@ func GetCmdQueryState() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryHolds implements the query holds command.
+func GetCmdQueryHolds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holds [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the active escrow holds of an address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Holds(cmd.Context(), &types.QueryHoldsRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryWatches implements the query watches command.
+func GetCmdQueryWatches() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watches [address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the balance watches registered by the VM, optionally for one address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryWatchesRequest{}
+			if len(args) > 0 {
+				req.Address = args[0]
+			}
+			res, err := queryClient.Watches(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRewardHistory implements the query reward-history command.
+func GetCmdQueryRewardHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-history [limit]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the most recent reward epochs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRewardHistoryRequest{}
+			if len(args) > 0 {
+				limit, err := strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+				req.Limit = uint32(limit)
+			}
+			res, err := queryClient.RewardHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryProjectedRewards implements the query projected-rewards command.
+func GetCmdQueryProjectedRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-rewards [n-blocks]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the distribution of the reward pool over the next n blocks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nBlocks, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.ProjectedRewards(cmd.Context(), &types.QueryProjectedRewardsRequest{NBlocks: nBlocks})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPoolFlows implements the query pool-flows command.
+func GetCmdQueryPoolFlows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-flows [pool]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the cumulative flows into and out of the accounted pools, optionally for one pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPoolFlowsRequest{}
+			if len(args) > 0 {
+				req.Pool = args[0]
+			}
+			res, err := queryClient.PoolFlows(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBalancesAt implements the query balances-at command.
+func GetCmdQueryBalancesAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances-at [address] [height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the snapshot of an address's balances at or before a height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.BalancesAt(cmd.Context(), &types.QueryBalancesAtRequest{Address: args[0], Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Snapshot)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -18,6 +18,62 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	seenHolds := make(map[string]bool, len(data.Holds))
+	for _, hold := range data.Holds {
+		if err := hold.ValidateBasic(); err != nil {
+			return err
+		}
+		if seenHolds[hold.Id] {
+			return fmt.Errorf("duplicate hold %q", hold.Id)
+		}
+		seenHolds[hold.Id] = true
+	}
+	seenFlows := make(map[string]bool, len(data.PoolFlows))
+	for _, flow := range data.PoolFlows {
+		if err := flow.ValidateBasic(); err != nil {
+			return err
+		}
+		key := flow.Pool + " " + flow.Direction + " " + flow.Category
+		if seenFlows[key] {
+			return fmt.Errorf("duplicate pool flow %s", key)
+		}
+		seenFlows[key] = true
+	}
+	seenWatches := make(map[string]bool, len(data.Watches))
+	for _, watch := range data.Watches {
+		if err := watch.ValidateBasic(); err != nil {
+			return err
+		}
+		key := watch.Address + " " + watch.Denom
+		if seenWatches[key] {
+			return fmt.Errorf("duplicate watch %s", key)
+		}
+		seenWatches[key] = true
+	}
+	snapshotAddresses := make(map[string]bool, len(data.SnapshotAddresses))
+	for _, address := range data.SnapshotAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("cannot convert snapshot address %s: %s", address, err)
+		}
+		if snapshotAddresses[address] {
+			return fmt.Errorf("duplicate snapshot address %s", address)
+		}
+		snapshotAddresses[address] = true
+	}
+	seenSnapshots := make(map[string]bool, len(data.BalanceSnapshots))
+	for _, snapshot := range data.BalanceSnapshots {
+		if err := snapshot.ValidateBasic(); err != nil {
+			return err
+		}
+		if !snapshotAddresses[snapshot.Address] {
+			return fmt.Errorf("snapshot of %s, which is not registered", snapshot.Address)
+		}
+		key := fmt.Sprintf("%s %d", snapshot.Address, snapshot.Height)
+		if seenSnapshots[key] {
+			return fmt.Errorf("duplicate balance snapshot %s", key)
+		}
+		seenSnapshots[key] = true
+	}
 	return nil
 }
 
@@ -30,7 +86,39 @@ func DefaultGenesisState() *types.GenesisState {
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) {
 	keeper.SetParams(ctx, data.GetParams())
-	_ = keeper.SetState(ctx, data.GetState())
+	if err := keeper.SetState(ctx, data.GetState()); err != nil {
+		panic(err)
+	}
+	for _, hold := range data.GetHolds() {
+		if err := keeper.SetHold(ctx, hold); err != nil {
+			panic(err)
+		}
+	}
+	// The bank genesis is initialized first, so the hold pool must already
+	// contain exactly the held coins.
+	if err := keeper.HoldPoolBalanceInvariant(ctx); err != nil {
+		panic(err)
+	}
+	for _, flow := range data.GetPoolFlows() {
+		if err := keeper.SetPoolFlow(ctx, flow); err != nil {
+			panic(err)
+		}
+	}
+	for _, watch := range data.GetWatches() {
+		if err := keeper.SetWatch(ctx, watch); err != nil {
+			panic(err)
+		}
+	}
+	for _, address := range data.GetSnapshotAddresses() {
+		if err := keeper.SetSnapshotAddress(ctx, address); err != nil {
+			panic(err)
+		}
+	}
+	for _, snapshot := range data.GetBalanceSnapshots() {
+		if err := keeper.SetBalanceSnapshot(ctx, snapshot); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) (*types.GenesisState, error) {
@@ -39,9 +127,26 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (*types.GenesisState, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to export vbank state: %s", err)
 	}
+	holds, err := k.GetAllHolds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export vbank holds: %s", err)
+	}
+	poolFlows, err := k.GetPoolFlows(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to export vbank pool flows: %s", err)
+	}
+	snapshots, err := k.GetAllBalanceSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export vbank balance snapshots: %s", err)
+	}
 	gs := &types.GenesisState{
-		Params: params,
-		State:  state,
+		Params:            params,
+		State:             state,
+		Holds:             holds,
+		PoolFlows:         poolFlows,
+		Watches:           k.GetWatches(ctx, ""),
+		SnapshotAddresses: k.GetSnapshotAddresses(ctx),
+		BalanceSnapshots:  snapshots,
 	}
 	return gs, nil
 }
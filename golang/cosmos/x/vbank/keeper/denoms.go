@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// The denom lookups made by the VM are cached in the transient store, so each
+// is made at most once per block.  A cached metadata value is prefixed by
+// whether the denom has any.
+const (
+	denomMetadataCachePrefix string = "denomMetadataCache/"
+	ibcDenomCachePrefix      string = "ibcDenomCache/"
+)
+
+// GetDenomMetadata returns the bank metadata of denom, if any.
+func (k Keeper) GetDenomMetadata(ctx sdk.Context, denom string) (banktypes.Metadata, bool, error) {
+	var metadata banktypes.Metadata
+	cache := k.openTransientPrefixStore(ctx, denomMetadataCachePrefix)
+	if bz := cache.Get([]byte(denom)); len(bz) > 0 {
+		if err := k.cdc.Unmarshal(bz[1:], &metadata); err != nil {
+			return metadata, false, err
+		}
+		return metadata, bz[0] == 1, nil
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	bz, err := k.cdc.Marshal(&metadata)
+	if err != nil {
+		return metadata, false, err
+	}
+	if found {
+		bz = append([]byte{1}, bz...)
+	} else {
+		bz = append([]byte{0}, bz...)
+	}
+	cache.Set([]byte(denom), bz)
+	return metadata, found, nil
+}
+
+// ResolveIBCDenom returns the trace of an "ibc/<hash>" denom recorded by the
+// ICS-20 module.  Any other denom is native, with an empty trace.
+func (k Keeper) ResolveIBCDenom(ctx sdk.Context, denom string) (transfertypes.Denom, error) {
+	var resolved transfertypes.Denom
+	hexHash, isIBC := strings.CutPrefix(denom, transfertypes.DenomPrefix+"/")
+	if !isIBC {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return resolved, fmt.Errorf("invalid denom %s: %s", denom, err)
+		}
+		return transfertypes.NewDenom(denom), nil
+	}
+
+	cache := k.openTransientPrefixStore(ctx, ibcDenomCachePrefix)
+	if bz := cache.Get([]byte(denom)); bz != nil {
+		err := k.cdc.Unmarshal(bz, &resolved)
+		return resolved, err
+	}
+
+	hash, err := transfertypes.ParseHexHash(hexHash)
+	if err != nil {
+		return resolved, fmt.Errorf("invalid IBC denom %s: %s", denom, err)
+	}
+	if *k.transferKeeper == nil {
+		return resolved, fmt.Errorf("cannot resolve %s: no transfer keeper", denom)
+	}
+	resolved, found := (*k.transferKeeper).GetDenom(ctx, hash)
+	if !found {
+		return resolved, fmt.Errorf("unknown IBC denom %s", denom)
+	}
+	bz, err := k.cdc.Marshal(&resolved)
+	if err != nil {
+		return resolved, err
+	}
+	cache.Set([]byte(denom), bz)
+	return resolved, nil
+}
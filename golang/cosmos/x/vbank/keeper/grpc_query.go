@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -28,3 +31,104 @@ func (k Keeper) State(c context.Context, req *types.QueryStateRequest) (*types.Q
 
 	return &types.QueryStateResponse{State: state}, nil
 }
+
+// Holds queries the active escrow holds of an account
+func (k Keeper) Holds(c context.Context, req *types.QueryHoldsRequest) (*types.QueryHoldsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	holds, err := k.GetHoldsByOwner(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHoldsResponse{Holds: holds}, nil
+}
+
+// Watches queries the balance watches registered by the VM
+func (k Keeper) Watches(c context.Context, req *types.QueryWatchesRequest) (*types.QueryWatchesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Address) > 0 {
+		if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryWatchesResponse{Watches: k.GetWatches(ctx, req.Address)}, nil
+}
+
+// RewardHistory queries the most recent reward epochs
+func (k Keeper) RewardHistory(c context.Context, req *types.QueryRewardHistoryRequest) (*types.QueryRewardHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	epochs, err := k.GetRewardHistory(ctx, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRewardHistoryResponse{Epochs: epochs}, nil
+}
+
+// ProjectedRewards simulates the distribution of the reward pool
+func (k Keeper) ProjectedRewards(c context.Context, req *types.QueryProjectedRewardsRequest) (*types.QueryProjectedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.NBlocks <= 0 || req.NBlocks > MaxProjectedRewardBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "n_blocks must be between 1 and %d", MaxProjectedRewardBlocks)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	total, rewardPool, epochs, err := k.ProjectRewards(ctx, req.NBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProjectedRewardsResponse{
+		Total:      total,
+		RewardPool: rewardPool,
+		Epochs:     epochs,
+	}, nil
+}
+
+// PoolFlows queries the cumulative flows of the accounted pools
+func (k Keeper) PoolFlows(c context.Context, req *types.QueryPoolFlowsRequest) (*types.QueryPoolFlowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Pool) > 0 && !slices.Contains(types.AccountedPools, req.Pool) {
+		return nil, status.Errorf(codes.InvalidArgument, "pool %q is not accounted", req.Pool)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	flows, err := k.GetPoolFlows(ctx, req.Pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolFlowsResponse{Flows: flows}, nil
+}
+
+// BalancesAt queries the balances of an address at a past height
+func (k Keeper) BalancesAt(c context.Context, req *types.QueryBalancesAtRequest) (*types.QueryBalancesAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	snapshot, err := k.GetBalancesAt(ctx, req.Address, req.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryBalancesAtResponse{Snapshot: snapshot}, nil
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// Holds are stored by id, and indexed by owner and by expiry time so that
+// both the Holds query and the EndBlock expiry sweep avoid full scans.
+const (
+	holdPrefix       string = "hold/"
+	holdOwnerPrefix  string = "holdOwner/"
+	holdExpiryPrefix string = "holdExpiry/"
+)
+
+const (
+	// MaxHoldReleaseAttempts is the number of times that releasing an expired
+	// hold may fail before it is left for the VM to release or capture.
+	MaxHoldReleaseAttempts = 8
+	// HoldReleaseRetryDelay is the delay in seconds before releasing an
+	// expired hold is retried for the first time.  Each later retry waits
+	// twice as long as the one before.
+	HoldReleaseRetryDelay int64 = 60
+)
+
+func holdOwnerKey(owner, id string) []byte {
+	// Bech32 addresses cannot contain "/", so the owner is unambiguous.
+	return []byte(owner + "/" + id)
+}
+
+func holdExpiryKey(expiryTime int64, id string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiryTime)), id...)
+}
+
+func (k Keeper) openPrefixStore(ctx sdk.Context, pfx string) prefix.Store {
+	kvstore := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(kvstore, []byte(pfx))
+}
+
+// GetHold returns the hold with the given id, if any.
+func (k Keeper) GetHold(ctx sdk.Context, id string) (types.Hold, bool, error) {
+	var hold types.Hold
+	bz := k.openPrefixStore(ctx, holdPrefix).Get([]byte(id))
+	if bz == nil {
+		return hold, false, nil
+	}
+	if err := k.cdc.Unmarshal(bz, &hold); err != nil {
+		return hold, false, err
+	}
+	return hold, true, nil
+}
+
+// SetHold records a hold and its indices without moving any coins, as when
+// importing genesis.
+func (k Keeper) SetHold(ctx sdk.Context, hold types.Hold) error {
+	bz, err := k.cdc.Marshal(&hold)
+	if err != nil {
+		return err
+	}
+	k.openPrefixStore(ctx, holdPrefix).Set([]byte(hold.Id), bz)
+	k.openPrefixStore(ctx, holdOwnerPrefix).Set(holdOwnerKey(hold.Owner, hold.Id), []byte{})
+	if hold.ExpiryTime > 0 {
+		k.openPrefixStore(ctx, holdExpiryPrefix).Set(holdExpiryKey(hold.ExpiryTime, hold.Id), []byte{})
+	}
+	return nil
+}
+
+func (k Keeper) deleteHold(ctx sdk.Context, hold types.Hold) {
+	k.openPrefixStore(ctx, holdPrefix).Delete([]byte(hold.Id))
+	k.openPrefixStore(ctx, holdOwnerPrefix).Delete(holdOwnerKey(hold.Owner, hold.Id))
+	if hold.ExpiryTime > 0 {
+		k.openPrefixStore(ctx, holdExpiryPrefix).Delete(holdExpiryKey(hold.ExpiryTime, hold.Id))
+	}
+}
+
+// CreateHold moves the hold's coins from its owner to the hold pool and
+// records the hold.
+func (k Keeper) CreateHold(ctx sdk.Context, hold types.Hold) error {
+	if err := hold.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, found, err := k.GetHold(ctx, hold.Id); err != nil {
+		return err
+	} else if found {
+		return fmt.Errorf("hold %q already exists", hold.Id)
+	}
+	owner, err := sdk.AccAddressFromBech32(hold.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.HoldPoolName, hold.Coins); err != nil {
+		return err
+	}
+	return k.SetHold(ctx, hold)
+}
+
+// mustGetHold returns the hold with the given id, or an error if there is
+// none.
+func (k Keeper) mustGetHold(ctx sdk.Context, id string) (types.Hold, error) {
+	hold, found, err := k.GetHold(ctx, id)
+	if err != nil {
+		return hold, err
+	}
+	if !found {
+		return hold, fmt.Errorf("hold %q not found", id)
+	}
+	return hold, nil
+}
+
+// ReleaseHold returns the coins of a hold to its owner and deletes it.
+func (k Keeper) ReleaseHold(ctx sdk.Context, id string) (types.Hold, error) {
+	hold, err := k.mustGetHold(ctx, id)
+	if err != nil {
+		return hold, err
+	}
+	owner, err := sdk.AccAddressFromBech32(hold.Owner)
+	if err != nil {
+		return hold, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.HoldPoolName, owner, hold.Coins); err != nil {
+		return hold, err
+	}
+	k.deleteHold(ctx, hold)
+	return hold, nil
+}
+
+// CaptureHold burns the coins of a hold, completing the withdrawal from its
+// owner as VBANK_GRAB would have, and deletes it.
+func (k Keeper) CaptureHold(ctx sdk.Context, id string) (types.Hold, error) {
+	hold, err := k.mustGetHold(ctx, id)
+	if err != nil {
+		return hold, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.HoldPoolName, types.ModuleName, hold.Coins); err != nil {
+		return hold, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, hold.Coins); err != nil {
+		return hold, err
+	}
+	k.deleteHold(ctx, hold)
+	return hold, nil
+}
+
+// postponeHoldRelease records a failed attempt to release an expired hold,
+// postponing its expiry time for a retry, or clearing it once the hold has
+// failed MaxHoldReleaseAttempts times.
+func (k Keeper) postponeHoldRelease(ctx sdk.Context, id string, now int64) (types.Hold, error) {
+	hold, err := k.mustGetHold(ctx, id)
+	if err != nil {
+		return hold, err
+	}
+	k.deleteHold(ctx, hold)
+	hold.ReleaseAttempts++
+	if hold.ReleaseAttempts >= MaxHoldReleaseAttempts {
+		hold.ExpiryTime = 0
+	} else {
+		hold.ExpiryTime = now + HoldReleaseRetryDelay<<(hold.ReleaseAttempts-1)
+	}
+	return hold, k.SetHold(ctx, hold)
+}
+
+// HoldPoolBalanceInvariant returns an error unless the coins of the active
+// holds sum to the balance of the hold pool.
+func (k Keeper) HoldPoolBalanceInvariant(ctx sdk.Context) error {
+	holds, err := k.GetAllHolds(ctx)
+	if err != nil {
+		return err
+	}
+	held := sdk.NewCoins()
+	for _, hold := range holds {
+		held = held.Add(hold.Coins...)
+	}
+	poolBalance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.HoldPoolName))
+	if !held.Equal(poolBalance) {
+		return fmt.Errorf("holds total %s, but the %s pool has %s", held, types.HoldPoolName, poolBalance)
+	}
+	return nil
+}
+
+// GetHoldsByOwner returns the active holds of owner, ordered by id.
+func (k Keeper) GetHoldsByOwner(ctx sdk.Context, owner string) ([]types.Hold, error) {
+	holds := []types.Hold{}
+	ownerStore := prefix.NewStore(k.openPrefixStore(ctx, holdOwnerPrefix), []byte(owner+"/"))
+	iterator := ownerStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		hold, err := k.mustGetHold(ctx, string(iterator.Key()))
+		if err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+	return holds, nil
+}
+
+// GetAllHolds returns every active hold, ordered by id.
+func (k Keeper) GetAllHolds(ctx sdk.Context) ([]types.Hold, error) {
+	holds := []types.Hold{}
+	iterator := k.openPrefixStore(ctx, holdPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var hold types.Hold
+		if err := k.cdc.Unmarshal(iterator.Value(), &hold); err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+	return holds, nil
+}
+
+// ReleaseExpiredHolds releases every hold whose expiry time is at or before
+// the block time, returning those released in order of expiry.  A hold that
+// cannot be released is retried with exponential backoff, up to
+// MaxHoldReleaseAttempts times.
+func (k Keeper) ReleaseExpiredHolds(ctx sdk.Context) []types.Hold {
+	now := ctx.BlockTime().Unix()
+	if now <= 0 {
+		return nil
+	}
+	expiryStore := k.openPrefixStore(ctx, holdExpiryPrefix)
+	// Every key for an expiry time at or before now sorts before this one.
+	iterator := expiryStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(now+1)))
+	// Collect the ids first, since releasing modifies the store being iterated.
+	var ids []string
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, string(iterator.Key()[8:]))
+	}
+	iterator.Close()
+
+	var released []types.Hold
+	for _, id := range ids {
+		// A hold that cannot be released (e.g., because its owner can no longer
+		// receive funds) must not halt the chain, so leave it to be captured or
+		// retried in a later block.
+		cacheCtx, writeCache := ctx.CacheContext()
+		hold, err := k.ReleaseHold(cacheCtx, id)
+		if err != nil {
+			postponed, postponeErr := k.postponeHoldRelease(ctx, id, now)
+			if postponeErr != nil {
+				ctx.Logger().Error("cannot postpone expired hold", "id", id, "err", postponeErr)
+				continue
+			}
+			if postponed.ExpiryTime == 0 {
+				ctx.Logger().Error("giving up on releasing expired hold", "id", id, "attempts", postponed.ReleaseAttempts, "err", err)
+			} else {
+				ctx.Logger().Error("cannot release expired hold", "id", id, "retryTime", postponed.ExpiryTime, "err", err)
+			}
+			continue
+		}
+		writeCache()
+		released = append(released, hold)
+	}
+	return released
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
@@ -11,7 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktypeserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -33,9 +36,14 @@ type Keeper struct {
 	cdc           codec.Codec
 	paramSpace    paramtypes.Subspace
 
-	accountKeeper         types.AccountKeeper
-	bankKeeper            types.BankKeeper
+	accountKeeper      types.AccountKeeper
+	bankKeeper         types.BankKeeper
+	distributionKeeper types.DistributionKeeper
+	// transferKeeper is shared by every copy of the keeper, since it is set
+	// after copies have been handed out (see SetTransferKeeper).
+	transferKeeper        *types.TransferKeeper
 	rewardDistributorName string
+	authority             string
 	PushAction            vm.ActionPusher
 	AddressToUpdate       map[string]sdk.Coins // address string -> Coins
 }
@@ -63,6 +71,7 @@ func NewKeeper(
 		paramSpace:            paramSpace,
 		accountKeeper:         accountKeeper,
 		bankKeeper:            bankKeeper,
+		transferKeeper:        new(types.TransferKeeper),
 		rewardDistributorName: rewardDistributorName,
 		PushAction:            pushAction,
 	}
@@ -71,6 +80,29 @@ func NewKeeper(
 	return k
 }
 
+// WithDistributionKeeper returns a copy of the keeper that can fund the
+// community pool with reward splits.
+func (k Keeper) WithDistributionKeeper(distributionKeeper types.DistributionKeeper) Keeper {
+	k.distributionKeeper = distributionKeeper
+	return k
+}
+
+// WithAuthority returns a copy of the keeper that accepts governance messages
+// from authority.
+func (k Keeper) WithAuthority(authority string) Keeper {
+	k.authority = authority
+	return k
+}
+
+// SetTransferKeeper sets the ICS-20 keeper used to resolve IBC denoms, which
+// is created after vbank.
+func (k Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	*k.transferKeeper = transferKeeper
+}
+
+// monitorSend is a bank send restriction that records the balances changed by
+// a send, for the VBANK_BALANCE_UPDATE of those watched at the end of the
+// block, and accounts for any flow into or out of the accounted pools.
 func (k Keeper) monitorSend(
 	ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
 ) (sdk.AccAddress, error) {
@@ -82,6 +114,9 @@ func (k Keeper) monitorSend(
 	if err := k.ensureAddressUpdate(adStore, toAddr, amt); err != nil {
 		return nil, sdkerrors.Wrap(sdktypeserrors.ErrInvalidRequest, err.Error())
 	}
+	if err := k.recordPoolFlows(sdkCtx, fromAddr, toAddr, amt); err != nil {
+		return nil, sdkerrors.Wrap(sdktypeserrors.ErrInvalidRequest, err.Error())
+	}
 	return toAddr, nil
 }
 
@@ -126,22 +161,69 @@ func (k Keeper) StoreRewardCoins(ctx sdk.Context, amt sdk.Coins) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, amt)
 }
 
+// SendCoinsToRewardDistributor sends rewards to the reward distributor, or
+// divides them according to the reward_splits param.
 func (k Keeper) SendCoinsToRewardDistributor(ctx sdk.Context, amt sdk.Coins) error {
-	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.rewardDistributorName, amt)
+	ctx = agoric.WithPoolFlowCategory(ctx, agoric.PoolFlowCategoryRewards)
+	splits := k.GetParams(ctx).RewardSplits
+	if len(splits) == 0 {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.rewardDistributorName, amt)
+	}
+	for i, share := range splitCoins(amt, splits) {
+		if share.IsZero() {
+			continue
+		}
+		if err := k.sendRewardShare(ctx, splits[i].Destination, share); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(types.NewRewardSplitEvent(splits[i], share))
+	}
+	return nil
+}
+
+// sendRewardShare sends a share of rewards to a RewardSplit destination.  As
+// a misconfigured param must not halt the chain, the share goes to the reward
+// distributor if the destination is not one of types.RewardSplitDestinations
+// (which params validation otherwise ensures) or is not available.
+func (k Keeper) sendRewardShare(ctx sdk.Context, destination string, share sdk.Coins) error {
+	switch {
+	case destination == types.RewardSplitCommunityPool && k.distributionKeeper != nil:
+		return k.distributionKeeper.FundCommunityPool(ctx, share, authtypes.NewModuleAddress(types.ModuleName))
+	case destination != types.RewardSplitCommunityPool && slices.Contains(types.RewardSplitDestinations, destination):
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination, share)
+	default:
+		ctx.Logger().Error("reward split destination unavailable; sending to reward distributor",
+			"destination", destination, "distributor", k.rewardDistributorName)
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.rewardDistributorName, share)
+	}
 }
 
+// SendCoins mints coins to an account, subject to the outflow limits.
 func (k Keeper) SendCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.trackOutflow(ctx, amt, true); err != nil {
+		return err
+	}
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amt); err != nil {
 		return err
 	}
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amt)
 }
 
+// GrabCoins burns coins from an account, offsetting the outflows of the
+// current windows.
 func (k Keeper) GrabCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, amt); err != nil {
 		return err
 	}
-	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt)
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt); err != nil {
+		return err
+	}
+	return k.trackOutflow(ctx, amt, false)
+}
+
+// TransferCoins moves coins directly between two accounts.
+func (k Keeper) TransferCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
 }
 
 func (k Keeper) GetModuleAccountAddress(ctx sdk.Context, name string) sdk.AccAddress {
@@ -168,6 +250,7 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
+	k.setLegacyMonitoring(ctx, params.AllowedMonitoringAccounts)
 }
 
 func (k Keeper) GetState(ctx sdk.Context) (types.State, error) {
@@ -198,11 +281,15 @@ func (k Keeper) GetNextSequence(ctx sdk.Context) (uint64, error) {
 	return state.LastSequence, k.SetState(ctx, state)
 }
 
-func (k Keeper) OpenAddressToUpdateStore(ctx sdk.Context) prefix.Store {
+func (k Keeper) openTransientPrefixStore(ctx sdk.Context, pfx string) prefix.Store {
 	store := k.tstoreService.OpenTransientStore(ctx)
 	if store == nil {
 		panic("transient store is nil")
 	}
 	kvstore := runtime.KVStoreAdapter(store)
-	return prefix.NewStore(kvstore, []byte(addressToUpdatePrefix))
+	return prefix.NewStore(kvstore, []byte(pfx))
+}
+
+func (k Keeper) OpenAddressToUpdateStore(ctx sdk.Context) prefix.Store {
+	return k.openTransientPrefixStore(ctx, addressToUpdatePrefix)
 }
@@ -28,3 +28,25 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.RewardHistoryEpochs = types.DefaultRewardHistoryEpochs
+	m.keeper.SetParams(ctx, params)
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4, setting the default limits of
+// balance snapshots.  Setting the parameters also mirrors the deprecated
+// AllowedMonitoringAccounts parameter into the store, so that sends no longer
+// read the parameters.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxSnapshotAddresses = types.DefaultMaxSnapshotAddresses
+	params.SnapshotRetentionBlocks = types.DefaultSnapshotRetentionBlocks
+	m.keeper.SetParams(ctx, params)
+
+	return nil
+}
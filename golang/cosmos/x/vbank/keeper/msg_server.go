@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktypeserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the vbank MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) SetOutflowLimit(goCtx context.Context, msg *types.MsgSetOutflowLimit) (*types.MsgSetOutflowLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(k.authority) == 0 || msg.Authority != k.authority {
+		return nil, sdkerrors.Wrap(sdktypeserrors.ErrUnauthorized, "only governance authority can call SetOutflowLimit")
+	}
+
+	params := k.GetParams(ctx)
+	params.OutflowLimits = types.SetOutflowLimit(params.OutflowLimits, msg.Limit)
+	if err := params.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdktypeserrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetParams(ctx, params)
+	k.ResetOutflowWindow(ctx, msg.Limit.Denom)
+
+	return &types.MsgSetOutflowLimitResponse{}, nil
+}
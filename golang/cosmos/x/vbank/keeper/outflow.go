@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// outflowWindowPrefix maps a limited denom to its current OutflowWindow.
+const outflowWindowPrefix string = "outflowWindow/"
+
+// GetOutflowWindow returns the recorded window of denom, if any.
+func (k Keeper) GetOutflowWindow(ctx sdk.Context, denom string) (types.OutflowWindow, bool, error) {
+	var window types.OutflowWindow
+	bz := k.openPrefixStore(ctx, outflowWindowPrefix).Get([]byte(denom))
+	if bz == nil {
+		return window, false, nil
+	}
+	if err := k.cdc.Unmarshal(bz, &window); err != nil {
+		return window, false, err
+	}
+	return window, true, nil
+}
+
+func (k Keeper) setOutflowWindow(ctx sdk.Context, window types.OutflowWindow) error {
+	bz, err := k.cdc.Marshal(&window)
+	if err != nil {
+		return err
+	}
+	k.openPrefixStore(ctx, outflowWindowPrefix).Set([]byte(window.Denom), bz)
+	return nil
+}
+
+// ResetOutflowWindow forgets the net outflow of denom, so that its next window
+// starts afresh.
+func (k Keeper) ResetOutflowWindow(ctx sdk.Context, denom string) {
+	k.openPrefixStore(ctx, outflowWindowPrefix).Delete([]byte(denom))
+}
+
+// currentOutflowWindow returns the window of limit's denom that includes the
+// current block.
+func (k Keeper) currentOutflowWindow(ctx sdk.Context, limit types.OutflowLimit) (types.OutflowWindow, error) {
+	window, found, err := k.GetOutflowWindow(ctx, limit.Denom)
+	if err != nil {
+		return window, err
+	}
+	height := ctx.BlockHeight()
+	if !found || height >= window.StartBlock+limit.WindowBlocks {
+		window = types.OutflowWindow{Denom: limit.Denom, StartBlock: height, NetOutflow: sdkmath.ZeroInt()}
+	}
+	return window, nil
+}
+
+// trackOutflow adds amt to (or, for an inflow, subtracts it from) the net
+// outflow of each limited denom.  If an outflow would exceed a limit, it
+// emits an outflow_limit_tripped event and returns ErrOutflowLimitExceeded
+// without recording anything.
+func (k Keeper) trackOutflow(ctx sdk.Context, amt sdk.Coins, outflow bool) error {
+	limits := k.GetParams(ctx).OutflowLimits
+	windows := make([]types.OutflowWindow, 0, len(limits))
+	for _, limit := range limits {
+		amount := amt.AmountOf(limit.Denom)
+		if amount.IsZero() {
+			continue
+		}
+		window, err := k.currentOutflowWindow(ctx, limit)
+		if err != nil {
+			return err
+		}
+		if !outflow {
+			window.NetOutflow = window.NetOutflow.Sub(amount)
+			windows = append(windows, window)
+			continue
+		}
+		window.NetOutflow = window.NetOutflow.Add(amount)
+		if window.NetOutflow.GT(limit.Amount) {
+			ctx.EventManager().EmitEvent(types.NewOutflowLimitTrippedEvent(limit, window.NetOutflow))
+			return sdkerrors.Wrapf(types.ErrOutflowLimitExceeded,
+				"sending %s%s would bring the net outflow since block %d to %s, over the limit of %s per %d blocks",
+				amount, limit.Denom, window.StartBlock, window.NetOutflow, limit.Amount, limit.WindowBlocks)
+		}
+		windows = append(windows, window)
+	}
+	for _, window := range windows {
+		if err := k.setOutflowWindow(ctx, window); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// poolFlowPrefix maps "<pool>\x00<direction>\x00<category>" to the cumulative
+// PoolFlow.  Pool names contain "/", so a NUL separates the key's parts.
+// blockPoolFlowPrefix holds the same in the transient store, for the flows of
+// the current block.
+const (
+	poolFlowPrefix      string = "poolFlow/"
+	blockPoolFlowPrefix string = "blockPoolFlow/"
+)
+
+// accountedPoolAddresses maps the address bytes of each accounted pool to its
+// name.
+var accountedPoolAddresses = func() map[string]string {
+	addrs := make(map[string]string, len(types.AccountedPools))
+	for _, name := range types.AccountedPools {
+		addrs[string(authtypes.NewModuleAddress(name))] = name
+	}
+	return addrs
+}()
+
+func poolFlowKey(pool, direction, category string) []byte {
+	return []byte(pool + "\x00" + direction + "\x00" + category)
+}
+
+func (k Keeper) openBlockPoolFlowStore(ctx sdk.Context) prefix.Store {
+	return k.openTransientPrefixStore(ctx, blockPoolFlowPrefix)
+}
+
+// recordPoolFlows accounts for a send to or from an accounted pool, under the
+// category of ctx.  Mints and burns bypass the bank's send restrictions, so
+// they are never recorded, but the accounted pools have neither the minter nor
+// the burner permission, so only sends change their balances.
+func (k Keeper) recordPoolFlows(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if amt.IsZero() || bytes.Equal(fromAddr, toAddr) {
+		return nil
+	}
+	category := agoric.PoolFlowCategory(ctx)
+	if len(category) == 0 {
+		category = agoric.PoolFlowCategoryOther
+	}
+	if pool, ok := accountedPoolAddresses[string(fromAddr)]; ok {
+		if err := k.addPoolFlow(ctx, types.PoolFlow{Pool: pool, Direction: types.PoolFlowOut, Category: category, Amount: amt}); err != nil {
+			return err
+		}
+	}
+	if pool, ok := accountedPoolAddresses[string(toAddr)]; ok {
+		if err := k.addPoolFlow(ctx, types.PoolFlow{Pool: pool, Direction: types.PoolFlowIn, Category: category, Amount: amt}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addPoolFlow adds the amount of flow to both its cumulative total and its
+// total for the block.
+func (k Keeper) addPoolFlow(ctx sdk.Context, flow types.PoolFlow) error {
+	key := poolFlowKey(flow.Pool, flow.Direction, flow.Category)
+	for _, store := range []prefix.Store{k.openPrefixStore(ctx, poolFlowPrefix), k.openBlockPoolFlowStore(ctx)} {
+		total := types.PoolFlow{Pool: flow.Pool, Direction: flow.Direction, Category: flow.Category}
+		if bz := store.Get(key); bz != nil {
+			if err := k.cdc.Unmarshal(bz, &total); err != nil {
+				return err
+			}
+		}
+		total.Amount = total.Amount.Add(flow.Amount...)
+		bz, err := k.cdc.Marshal(&total)
+		if err != nil {
+			return err
+		}
+		store.Set(key, bz)
+	}
+	return nil
+}
+
+// SetPoolFlow records the cumulative total of a flow.
+func (k Keeper) SetPoolFlow(ctx sdk.Context, flow types.PoolFlow) error {
+	if err := flow.ValidateBasic(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&flow)
+	if err != nil {
+		return err
+	}
+	k.openPrefixStore(ctx, poolFlowPrefix).Set(poolFlowKey(flow.Pool, flow.Direction, flow.Category), bz)
+	return nil
+}
+
+func (k Keeper) readPoolFlows(store prefix.Store, pool string) ([]types.PoolFlow, error) {
+	flows := []types.PoolFlow{}
+	var iterStore prefix.Store = store
+	if len(pool) > 0 {
+		iterStore = prefix.NewStore(store, []byte(pool+"\x00"))
+	}
+	iterator := iterStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.PoolFlow
+		if err := k.cdc.Unmarshal(iterator.Value(), &flow); err != nil {
+			return nil, err
+		}
+		flows = append(flows, flow)
+	}
+	return flows, nil
+}
+
+// GetPoolFlows returns the cumulative flows of pool, or of every accounted
+// pool if it is empty, ordered by pool, direction and then category.
+func (k Keeper) GetPoolFlows(ctx sdk.Context, pool string) ([]types.PoolFlow, error) {
+	return k.readPoolFlows(k.openPrefixStore(ctx, poolFlowPrefix), pool)
+}
+
+// EmitBlockPoolFlowEvents emits a pool_flow event for each flow of the block.
+// It is called after every module's EndBlock, to include the flows caused by
+// the SwingSet VM.
+func (k Keeper) EmitBlockPoolFlowEvents(ctx sdk.Context) error {
+	flows, err := k.readPoolFlows(k.openBlockPoolFlowStore(ctx), "")
+	if err != nil {
+		return err
+	}
+	for _, flow := range flows {
+		ctx.EventManager().EmitEvent(types.NewPoolFlowEvent(flow))
+	}
+	return nil
+}
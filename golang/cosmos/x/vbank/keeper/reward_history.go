@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// rewardHistoryPrefix maps the big-endian start block of each recorded reward
+// epoch to its types.RewardEpoch, and rewardHistoryLenKey holds the number of
+// recorded epochs so that pruning need not count them.
+const (
+	rewardHistoryPrefix string = "rewardHistory/"
+	rewardHistoryLenKey string = "rewardHistoryLen"
+)
+
+// MaxProjectedRewardBlocks limits the number of blocks simulated by the
+// ProjectedRewards query.
+const MaxProjectedRewardBlocks = 100_000
+
+func rewardEpochKey(startBlock int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(startBlock))
+}
+
+func (k Keeper) getRewardHistoryLen(ctx sdk.Context) uint64 {
+	bz := k.openPrefixStore(ctx, "").Get([]byte(rewardHistoryLenKey))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setRewardHistoryLen(ctx sdk.Context, n uint64) {
+	k.openPrefixStore(ctx, "").Set([]byte(rewardHistoryLenKey), sdk.Uint64ToBigEndian(n))
+}
+
+func (k Keeper) getRewardEpoch(store prefix.Store, startBlock int64) (types.RewardEpoch, bool, error) {
+	var epoch types.RewardEpoch
+	bz := store.Get(rewardEpochKey(startBlock))
+	if bz == nil {
+		return epoch, false, nil
+	}
+	if err := k.cdc.Unmarshal(bz, &epoch); err != nil {
+		return epoch, false, err
+	}
+	return epoch, true, nil
+}
+
+// recordRewardEpoch updates the reward history with a step of the rewards
+// state machine, given the state after the step and the reward pool before
+// it.  Epochs that allot nothing are not recorded.
+func (k Keeper) recordRewardEpoch(ctx sdk.Context, params types.Params, state types.State, rewardPool sdk.Coins, step rewardStep) error {
+	if params.RewardHistoryEpochs <= 0 {
+		return nil
+	}
+	store := k.openPrefixStore(ctx, rewardHistoryPrefix)
+
+	var epoch types.RewardEpoch
+	isNew := step.newEpoch && !step.epochAmount.IsZero()
+	if isNew {
+		epoch = types.RewardEpoch{
+			StartBlock:             state.LastRewardDistributionBlock,
+			RewardPool:             rewardPool,
+			PerEpochRewardFraction: params.PerEpochRewardFraction,
+			EpochAmount:            step.epochAmount,
+		}
+	} else if !step.xfer.IsZero() {
+		var found bool
+		var err error
+		epoch, found, err = k.getRewardEpoch(store, state.LastRewardDistributionBlock)
+		if err != nil {
+			return err
+		}
+		if !found {
+			// The epoch started before the history was enabled.
+			return nil
+		}
+	} else {
+		return nil
+	}
+
+	if !step.xfer.IsZero() {
+		epoch.Distributed = epoch.Distributed.Add(step.xfer...)
+		epoch.LastDistributionBlock = ctx.BlockHeight()
+	}
+	bz, err := k.cdc.Marshal(&epoch)
+	if err != nil {
+		return err
+	}
+	store.Set(rewardEpochKey(epoch.StartBlock), bz)
+
+	if isNew {
+		k.pruneRewardHistory(ctx, k.getRewardHistoryLen(ctx)+1, uint64(params.RewardHistoryEpochs))
+	}
+	return nil
+}
+
+// pruneRewardHistory deletes the oldest of n recorded epochs until at most
+// retain remain.
+func (k Keeper) pruneRewardHistory(ctx sdk.Context, n, retain uint64) {
+	if n > retain {
+		store := k.openPrefixStore(ctx, rewardHistoryPrefix)
+		iterator := store.Iterator(nil, nil)
+		var stale [][]byte
+		for ; iterator.Valid() && n-uint64(len(stale)) > retain; iterator.Next() {
+			stale = append(stale, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range stale {
+			store.Delete(key)
+		}
+		n -= uint64(len(stale))
+	}
+	k.setRewardHistoryLen(ctx, n)
+}
+
+// GetRewardHistory returns up to limit (or all, if limit is zero) of the
+// recorded reward epochs, from most to least recent.
+func (k Keeper) GetRewardHistory(ctx sdk.Context, limit uint32) ([]types.RewardEpoch, error) {
+	epochs := []types.RewardEpoch{}
+	iterator := k.openPrefixStore(ctx, rewardHistoryPrefix).ReverseIterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if limit > 0 && len(epochs) >= int(limit) {
+			break
+		}
+		var epoch types.RewardEpoch
+		if err := k.cdc.Unmarshal(iterator.Value(), &epoch); err != nil {
+			return nil, err
+		}
+		epochs = append(epochs, epoch)
+	}
+	return epochs, nil
+}
+
+// ProjectRewards simulates the rewards state machine over the nBlocks after
+// the current one with the current params, assuming that nothing is added to
+// the reward pool.  It returns the total that would be distributed, the
+// reward pool that would remain, and the epochs starting within those blocks
+// that would distribute rewards.
+func (k Keeper) ProjectRewards(ctx sdk.Context, nBlocks int64) (sdk.Coins, sdk.Coins, []types.RewardEpoch, error) {
+	if nBlocks <= 0 || nBlocks > MaxProjectedRewardBlocks {
+		return nil, nil, nil, fmt.Errorf("number of blocks must be between 1 and %d: %d", MaxProjectedRewardBlocks, nBlocks)
+	}
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	params := k.GetParams(ctx)
+
+	total := sdk.NewCoins()
+	epochs := []types.RewardEpoch{}
+	var epoch *types.RewardEpoch
+	for height := ctx.BlockHeight() + 1; height <= ctx.BlockHeight()+nBlocks; height++ {
+		rewardPool := state.RewardPool
+		step := advanceRewards(&state, params, height)
+		if step.newEpoch {
+			epoch = nil
+			if !step.epochAmount.IsZero() {
+				epochs = append(epochs, types.RewardEpoch{
+					StartBlock:             height,
+					RewardPool:             rewardPool,
+					PerEpochRewardFraction: params.PerEpochRewardFraction,
+					EpochAmount:            step.epochAmount,
+				})
+				epoch = &epochs[len(epochs)-1]
+			}
+		}
+		if step.xfer.IsZero() {
+			continue
+		}
+		total = total.Add(step.xfer...)
+		if epoch != nil {
+			epoch.Distributed = epoch.Distributed.Add(step.xfer...)
+			epoch.LastDistributionBlock = height
+		}
+	}
+	return total, state.RewardPool, epochs, nil
+}
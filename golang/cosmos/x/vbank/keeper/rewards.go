@@ -5,6 +5,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 )
 
 // minCoins returns the minimum of each denomination.
@@ -44,43 +46,86 @@ func mulCoins(a sdk.Coins, b sdkmath.LegacyDec) sdk.Coins {
 	return sdk.NewCoins(coins...)
 }
 
-// DistributeRewards drives the rewards state machine.
-func (k Keeper) DistributeRewards(ctx sdk.Context) error {
-	// Distribute rewards.
-	state, err := k.GetState(ctx)
-	if err != nil {
-		return err
+// splitCoins divides amt between splits by weight, rounding down all but the
+// last share, which gets the remainder.
+func splitCoins(amt sdk.Coins, splits []types.RewardSplit) []sdk.Coins {
+	shares := make([]sdk.Coins, len(splits))
+	remainder := amt
+	for i, split := range splits {
+		if i == len(splits)-1 {
+			shares[i] = remainder
+			break
+		}
+		shares[i] = mulCoins(amt, split.Weight)
+		remainder = remainder.Sub(shares[i]...)
 	}
-	params := k.GetParams(ctx)
+	return shares
+}
+
+// rewardStep is the outcome of the rewards state machine for a single block.
+type rewardStep struct {
+	// newEpoch is true if an epoch started in the block.
+	newEpoch bool
+	// epochAmount is the amount allotted to a new epoch.
+	epochAmount sdk.Coins
+	// distributing is true if the block is within the smoothing period of
+	// its epoch.
+	distributing bool
+	// xfer is the amount to send to the reward distributor.
+	xfer sdk.Coins
+}
 
+// advanceRewards runs the rewards state machine for the block at thisBlock,
+// updating state.
+func advanceRewards(state *types.State, params types.Params, thisBlock int64) rewardStep {
+	var step rewardStep
 	smoothingBlocks := params.GetSmoothingBlocks()
-	thisBlock := ctx.BlockHeight()
 	cycleIndex := thisBlock - state.LastRewardDistributionBlock
 
 	// Check if we're at the end of the last cycle.
 	if cycleIndex >= params.RewardEpochDurationBlocks {
 		// Get more rewards to distribute.
-		toDistribute := mulCoins(state.RewardPool, params.PerEpochRewardFraction)
+		step.newEpoch = true
+		step.epochAmount = mulCoins(state.RewardPool, params.PerEpochRewardFraction)
 		state.LastRewardDistributionBlock = thisBlock
-		state.RewardBlockAmount = params.RewardRate(toDistribute, smoothingBlocks)
-		if err := k.SetState(ctx, state); err != nil {
-			return err
-		}
+		state.RewardBlockAmount = params.RewardRate(step.epochAmount, smoothingBlocks)
 	}
 
 	if cycleIndex >= smoothingBlocks {
 		// No more distribution to do until the next cycle.
-		return nil
+		return step
 	}
 
 	// We're currently within the smoothing period, send the amount to distribute.
-	xfer := minCoins(state.RewardBlockAmount, state.RewardPool)
-	if !xfer.IsZero() {
-		if err := k.SendCoinsToRewardDistributor(ctx, xfer); err != nil {
+	step.distributing = true
+	step.xfer = minCoins(state.RewardBlockAmount, state.RewardPool)
+	state.RewardPool = state.RewardPool.Sub(step.xfer...)
+	return step
+}
+
+// DistributeRewards drives the rewards state machine.
+func (k Keeper) DistributeRewards(ctx sdk.Context) error {
+	// Distribute rewards.
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return err
+	}
+	params := k.GetParams(ctx)
+	rewardPool := state.RewardPool
+
+	step := advanceRewards(&state, params, ctx.BlockHeight())
+	if !step.newEpoch && !step.distributing {
+		return nil
+	}
+
+	if !step.xfer.IsZero() {
+		if err := k.SendCoinsToRewardDistributor(ctx, step.xfer); err != nil {
 			return err
 		}
 	}
 
-	state.RewardPool = state.RewardPool.Sub(xfer...)
+	if err := k.recordRewardEpoch(ctx, params, state, rewardPool, step); err != nil {
+		return err
+	}
 	return k.SetState(ctx, state)
 }
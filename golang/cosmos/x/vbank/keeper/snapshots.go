@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// snapshotAddressPrefix holds the addresses registered for balance snapshots.
+// balanceSnapshotPrefix maps "<address>/<big-endian height>" to the
+// BalanceSnapshot taken at the end of that block.
+const (
+	snapshotAddressPrefix string = "snapshotAddress/"
+	balanceSnapshotPrefix string = "balanceSnapshot/"
+)
+
+func balanceSnapshotKey(address string, height int64) []byte {
+	return append([]byte(address+"/"), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func (k Keeper) openBalanceSnapshotStore(ctx sdk.Context, address string) prefix.Store {
+	return prefix.NewStore(k.openPrefixStore(ctx, balanceSnapshotPrefix), []byte(address+"/"))
+}
+
+// RegisterSnapshots registers address for balance snapshots, starting with the
+// next snapshot block.  A new address is refused once MaxSnapshotAddresses are
+// registered.
+func (k Keeper) RegisterSnapshots(ctx sdk.Context, address string) error {
+	registry := k.openPrefixStore(ctx, snapshotAddressPrefix)
+	if registry.Has([]byte(address)) {
+		return nil
+	}
+	if limit := k.GetParams(ctx).MaxSnapshotAddresses; limit > 0 {
+		if count := int64(len(k.GetSnapshotAddresses(ctx))); count >= limit {
+			return fmt.Errorf("%d addresses are already registered for snapshots, the maximum", count)
+		}
+	}
+	return k.SetSnapshotAddress(ctx, address)
+}
+
+// SetSnapshotAddress registers address for balance snapshots regardless of
+// MaxSnapshotAddresses, as when importing genesis.
+func (k Keeper) SetSnapshotAddress(ctx sdk.Context, address string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return fmt.Errorf("cannot convert %s to address: %s", address, err)
+	}
+	k.openPrefixStore(ctx, snapshotAddressPrefix).Set([]byte(address), []byte{})
+	return nil
+}
+
+// UnregisterSnapshots stops the balance snapshots of address and deletes those
+// already taken, so that a later registration does not leave a gap.
+func (k Keeper) UnregisterSnapshots(ctx sdk.Context, address string) error {
+	registry := k.openPrefixStore(ctx, snapshotAddressPrefix)
+	if !registry.Has([]byte(address)) {
+		return fmt.Errorf("%s is not registered for snapshots", address)
+	}
+	registry.Delete([]byte(address))
+
+	store := k.openBalanceSnapshotStore(ctx, address)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// GetSnapshotAddresses returns the addresses registered for balance
+// snapshots, in order.
+func (k Keeper) GetSnapshotAddresses(ctx sdk.Context) []string {
+	addresses := []string{}
+	iterator := k.openPrefixStore(ctx, snapshotAddressPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()))
+	}
+	return addresses
+}
+
+// SetBalanceSnapshot records a snapshot.
+func (k Keeper) SetBalanceSnapshot(ctx sdk.Context, snapshot types.BalanceSnapshot) error {
+	if err := snapshot.ValidateBasic(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&snapshot)
+	if err != nil {
+		return err
+	}
+	k.openPrefixStore(ctx, balanceSnapshotPrefix).Set(balanceSnapshotKey(snapshot.Address, snapshot.Height), bz)
+	return nil
+}
+
+// GetAllBalanceSnapshots returns every recorded snapshot, ordered by address
+// and then height.
+func (k Keeper) GetAllBalanceSnapshots(ctx sdk.Context) ([]types.BalanceSnapshot, error) {
+	snapshots := []types.BalanceSnapshot{}
+	iterator := k.openPrefixStore(ctx, balanceSnapshotPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.BalanceSnapshot
+		if err := k.cdc.Unmarshal(iterator.Value(), &snapshot); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// latestBalanceSnapshot returns the last snapshot of address taken at or
+// before height, if any.
+func (k Keeper) latestBalanceSnapshot(ctx sdk.Context, address string, height int64) (types.BalanceSnapshot, bool, error) {
+	var snapshot types.BalanceSnapshot
+	store := k.openBalanceSnapshotStore(ctx, address)
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return snapshot, false, nil
+	}
+	err := k.cdc.Unmarshal(iterator.Value(), &snapshot)
+	return snapshot, err == nil, err
+}
+
+// retainedSince returns the first height whose balances can be queried, given
+// the SnapshotRetentionBlocks as of the current block.
+func retainedSince(params types.Params, height int64) int64 {
+	if params.SnapshotRetentionBlocks <= 0 || height <= params.SnapshotRetentionBlocks {
+		return 1
+	}
+	return height - params.SnapshotRetentionBlocks + 1
+}
+
+// pruneBalanceSnapshots deletes the snapshots of address that are no longer
+// needed to give its balances since height, keeping the last one at or before
+// it.
+func (k Keeper) pruneBalanceSnapshots(ctx sdk.Context, address string, since int64) {
+	store := k.openBalanceSnapshotStore(ctx, address)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(since)+1))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	if len(keys) <= 1 {
+		return
+	}
+	for _, key := range keys[:len(keys)-1] {
+		store.Delete(key)
+	}
+}
+
+// TakeBalanceSnapshots records the balances of each registered address that
+// have changed since its last snapshot, and prunes the snapshots older than
+// SnapshotRetentionBlocks, if the current block is a snapshot block.  It must
+// run after the VM has finished the block, so that the snapshots include the
+// VM's transfers.
+func (k Keeper) TakeBalanceSnapshots(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	interval := params.SnapshotIntervalBlocks
+	height := ctx.BlockHeight()
+	if interval <= 0 || height%interval != 0 {
+		return nil
+	}
+	since := retainedSince(params, height)
+	for _, address := range k.GetSnapshotAddresses(ctx) {
+		if since > 1 {
+			k.pruneBalanceSnapshots(ctx, address, since)
+		}
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		balances := k.GetAllBalances(ctx, addr)
+		last, found, err := k.latestBalanceSnapshot(ctx, address, height)
+		if err != nil {
+			return err
+		}
+		if found && last.Balances.Equal(balances) {
+			continue
+		}
+		snapshot := types.BalanceSnapshot{Address: address, Height: height, Balances: balances}
+		if err := k.SetBalanceSnapshot(ctx, snapshot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBalancesAt returns the snapshot giving the balances of a registered address
+// at the end of block height, which is the last snapshot taken at or before
+// it.  The height must be within the last SnapshotRetentionBlocks, and not
+// after the block of ctx, whose snapshot is only taken once it has ended.
+func (k Keeper) GetBalancesAt(ctx sdk.Context, address string, height int64) (types.BalanceSnapshot, error) {
+	if height <= 0 {
+		return types.BalanceSnapshot{}, fmt.Errorf("height must be positive: %d", height)
+	}
+	if height > ctx.BlockHeight() {
+		return types.BalanceSnapshot{}, fmt.Errorf("height %d is in the future", height)
+	}
+	if since := retainedSince(k.GetParams(ctx), ctx.BlockHeight()); height < since {
+		return types.BalanceSnapshot{}, fmt.Errorf("height %d is before the retained snapshots, which start at %d", height, since)
+	}
+	if !k.openPrefixStore(ctx, snapshotAddressPrefix).Has([]byte(address)) {
+		return types.BalanceSnapshot{}, fmt.Errorf("%s is not registered for snapshots", address)
+	}
+	snapshot, found, err := k.latestBalanceSnapshot(ctx, address, height)
+	if err != nil {
+		return snapshot, err
+	}
+	if !found {
+		return snapshot, fmt.Errorf("no snapshot of %s at or before height %d", address, height)
+	}
+	return snapshot, nil
+}
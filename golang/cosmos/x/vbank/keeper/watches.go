@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+// watchPrefix maps "<address>/<denom>" to the big-endian count of the VM's
+// registrations of that watch.  Bech32 addresses cannot contain "/", so the
+// first one separates the address from the (possibly slashed) denom.
+const watchPrefix string = "watch/"
+
+// legacyMonitorPrefix holds the patterns of the deprecated
+// AllowedMonitoringAccounts parameter (addresses, or "*" for every address) as
+// keys, so that sends can consult them without reading the parameters.
+const legacyMonitorPrefix string = "legacyMonitor/"
+
+func watchKey(address, denom string) []byte {
+	return []byte(address + "/" + denom)
+}
+
+// getWatchCount returns the number of registrations of a watch.
+func (k Keeper) getWatchCount(store prefix.Store, address, denom string) uint64 {
+	bz := store.Get(watchKey(address, denom))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddWatch registers the VM's interest in balance updates of address in denom
+// (or in every denom if it is types.WatchAllDenoms), returning the number of
+// registrations of that watch.
+func (k Keeper) AddWatch(ctx sdk.Context, address, denom string) (uint64, error) {
+	if err := types.ValidateWatchTarget(address, denom); err != nil {
+		return 0, err
+	}
+	store := k.openPrefixStore(ctx, watchPrefix)
+	count := k.getWatchCount(store, address, denom) + 1
+	store.Set(watchKey(address, denom), sdk.Uint64ToBigEndian(count))
+	return count, nil
+}
+
+// SyncLegacyMonitoring mirrors the deprecated AllowedMonitoringAccounts
+// parameter into the store consulted by sends.  It is called whenever the
+// keeper sets the parameters, and at the beginning of every block to catch
+// changes made directly to the parameter subspace.
+func (k Keeper) SyncLegacyMonitoring(ctx sdk.Context) {
+	k.setLegacyMonitoring(ctx, k.GetParams(ctx).AllowedMonitoringAccounts)
+}
+
+// setLegacyMonitoring rewrites the legacy monitoring patterns that differ from
+// patterns.
+func (k Keeper) setLegacyMonitoring(ctx sdk.Context, patterns []string) {
+	legacy := k.openPrefixStore(ctx, legacyMonitorPrefix)
+	wanted := make(map[string]bool, len(patterns))
+	for _, pattern := range patterns {
+		if len(pattern) > 0 {
+			wanted[pattern] = true
+		}
+	}
+	var stale [][]byte
+	iterator := legacy.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		pattern := string(iterator.Key())
+		if wanted[pattern] {
+			delete(wanted, pattern)
+		} else {
+			stale = append(stale, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range stale {
+		legacy.Delete(key)
+	}
+	added := make([]string, 0, len(wanted))
+	for pattern := range wanted {
+		added = append(added, pattern)
+	}
+	slices.Sort(added)
+	for _, pattern := range added {
+		legacy.Set([]byte(pattern), []byte{})
+	}
+}
+
+// RemoveWatch undoes one AddWatch, returning the number of registrations that
+// remain.  The watch is deleted when none remain.
+func (k Keeper) RemoveWatch(ctx sdk.Context, address, denom string) (uint64, error) {
+	store := k.openPrefixStore(ctx, watchPrefix)
+	count := k.getWatchCount(store, address, denom)
+	if count == 0 {
+		return 0, fmt.Errorf("%s is not watched in %s", address, denom)
+	}
+	count--
+	if count == 0 {
+		store.Delete(watchKey(address, denom))
+	} else {
+		store.Set(watchKey(address, denom), sdk.Uint64ToBigEndian(count))
+	}
+	return count, nil
+}
+
+// SetWatch records a watch with the given count, deleting it if the count is
+// zero.
+func (k Keeper) SetWatch(ctx sdk.Context, watch types.Watch) error {
+	if err := types.ValidateWatchTarget(watch.Address, watch.Denom); err != nil {
+		return err
+	}
+	store := k.openPrefixStore(ctx, watchPrefix)
+	if watch.Count == 0 {
+		store.Delete(watchKey(watch.Address, watch.Denom))
+		return nil
+	}
+	store.Set(watchKey(watch.Address, watch.Denom), sdk.Uint64ToBigEndian(watch.Count))
+	return nil
+}
+
+// GetWatches returns the watches of address, or of every address if it is
+// empty, ordered by address and then denom.
+func (k Keeper) GetWatches(ctx sdk.Context, address string) []types.Watch {
+	watches := []types.Watch{}
+	var store prefix.Store
+	if len(address) > 0 {
+		store = prefix.NewStore(k.openPrefixStore(ctx, watchPrefix), []byte(address+"/"))
+	} else {
+		store = k.openPrefixStore(ctx, watchPrefix)
+	}
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		watch := types.Watch{Address: address, Count: sdk.BigEndianToUint64(iterator.Value())}
+		if len(address) > 0 {
+			watch.Denom = string(iterator.Key())
+		} else {
+			watch.Address, watch.Denom, _ = strings.Cut(string(iterator.Key()), "/")
+		}
+		watches = append(watches, watch)
+	}
+	return watches
+}
+
+// watchedDenoms returns the subset of coins whose denoms are watched for
+// address, either explicitly or because the address matches the deprecated
+// AllowedMonitoringAccounts parameter as mirrored by SyncLegacyMonitoring.
+// Watches only add to the parameter, which only governance changes.
+func (k Keeper) watchedDenoms(ctx sdk.Context, address string, coins sdk.Coins) sdk.Coins {
+	if coins.IsZero() {
+		return nil
+	}
+	store := k.openPrefixStore(ctx, watchPrefix)
+	legacy := k.openPrefixStore(ctx, legacyMonitorPrefix)
+	if store.Has(watchKey(address, types.WatchAllDenoms)) ||
+		legacy.Has([]byte(address)) ||
+		legacy.Has([]byte(types.AllowAllMonitoringAccountsPattern)) {
+		return coins
+	}
+	var watched sdk.Coins
+	for _, coin := range coins {
+		if store.Has(watchKey(address, coin.Denom)) {
+			watched = append(watched, coin)
+		}
+	}
+	return watched
+}
+
+// WatchedBalanceUpdates returns the watched denoms of each address whose
+// balance changed during the block.  Sends record every change, and the
+// watches are consulted only here, so that the watches and parameter in
+// effect at the end of the block apply to the whole block.
+func (k Keeper) WatchedBalanceUpdates(ctx sdk.Context) (map[string]sdk.Coins, error) {
+	updates := map[string]sdk.Coins{}
+	adStore := k.OpenAddressToUpdateStore(ctx)
+	iterator := adStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addr, err := k.AddressCodec().BytesToString(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		denoms, err := sdk.ParseCoinsNormalized(string(iterator.Value()))
+		if err != nil {
+			return nil, err
+		}
+		if watched := k.watchedDenoms(ctx, addr, denoms); !watched.IsZero() {
+			updates[addr] = watched
+		}
+	}
+	return updates, nil
+}
@@ -23,10 +23,11 @@ import (
 
 // type check to ensure the interface is properly implemented
 var (
-	_ module.AppModule        = AppModule{}
-	_ module.AppModuleBasic   = AppModuleBasic{}
-	_ module.HasGenesis       = AppModule{}
-	_ appmodule.HasEndBlocker = AppModule{}
+	_ module.AppModule          = AppModule{}
+	_ module.AppModuleBasic     = AppModuleBasic{}
+	_ module.HasGenesis         = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 )
 
 // app module Basics object
@@ -98,36 +99,40 @@ func (AppModule) Name() string {
 	return ModuleName
 }
 
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
+
+// BeginBlock implements the AppModule interface
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	// Pick up any change to the deprecated AllowedMonitoringAccounts parameter
+	// once per block, rather than on every send.
+	am.keeper.SyncLegacyMonitoring(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
 
 // EndBlock implements the AppModule interface
 func (am AppModule) EndBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// Prune the AddressToUpdate map to only include module accounts.  We prune
-	// only after recording and consolidating all account updates to minimize the
-	// number of account keeper queries.
-	filteredAddresses := make(map[string]sdk.Coins, len(am.keeper.AddressToUpdate))
-	adStore := am.keeper.OpenAddressToUpdateStore(sdkCtx)
-	iterator := adStore.Iterator(nil, nil)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		addrBz := iterator.Key()
-		addr, err := am.keeper.AddressCodec().BytesToString(addrBz)
-		if err != nil {
-			return err
+	// Release expired holds first, so that their owners' balance updates are
+	// included below.
+	expired := am.keeper.ReleaseExpiredHolds(sdkCtx)
+	if len(expired) > 0 {
+		holdIDs := make([]string, len(expired))
+		for i, hold := range expired {
+			holdIDs[i] = hold.Id
 		}
-		if am.keeper.IsAllowedMonitoringAccount(sdkCtx, addr) {
-			// We're committed to monitor this address, so parse the denoms.
-			denomsBz := iterator.Value()
-			denoms, err := sdk.ParseCoinsNormalized(string(denomsBz))
-			if err != nil {
-				return err
-			}
-			filteredAddresses[addr] = denoms
+		action := &VbankHoldsExpired{HoldIDs: holdIDs}
+		if err := am.pushActionWithMsgIdx(sdkCtx, holdsExpiredMsgIdx, action); err != nil {
+			return err
 		}
 	}
 
+	// Collect the watched balances changed during the block.
+	filteredAddresses, err := am.keeper.WatchedBalanceUpdates(sdkCtx)
+	if err != nil {
+		return err
+	}
+
 	// Dump all the addressToBalances entries to SwingSet.
 	action, err := getBalanceUpdate(sdkCtx, am.keeper, filteredAddresses)
 	if err != nil {
@@ -148,6 +153,17 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	return nil
 }
 
+// EndBlockAfterVM summarizes the block and takes the balance snapshots once
+// every end blocker has run, including SwingSet's (which runs after vbank's so
+// that it receives vbank's actions), so that they cover the VM's activity.  The
+// app must call it after its module manager's EndBlock.
+func EndBlockAfterVM(ctx sdk.Context, k Keeper) error {
+	if err := k.TakeBalanceSnapshots(ctx); err != nil {
+		return err
+	}
+	return k.EmitBlockPoolFlowEvents(ctx)
+}
+
 // RegisterInvariants implements the AppModule interface
 func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	// TODO
@@ -155,8 +171,7 @@ func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	tx := &types.UnimplementedMsgServer{}
-	types.RegisterMsgServer(cfg.MsgServer(), tx)
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
 	m := keeper.NewMigrator(am.keeper)
@@ -164,6 +179,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc-transfer module. It returns
@@ -0,0 +1,9 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// ErrOutflowLimitExceeded is returned when a send out of the vbank module
+// account would exceed the outflow limit of its denom.
+var ErrOutflowLimitExceeded = sdkerrors.Register(ModuleName, 2, "outflow limit exceeded")
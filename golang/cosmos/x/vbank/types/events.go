@@ -0,0 +1,62 @@
+package types
+
+import (
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	EventTypeRewardSplit         = "reward_split"
+	EventTypePoolFlow            = "pool_flow"
+	EventTypeOutflowLimitTripped = "outflow_limit_tripped"
+
+	AttributeKeyDestination  = "destination"
+	AttributeKeyWeight       = "weight"
+	AttributeKeyPool         = "pool"
+	AttributeKeyDirection    = "direction"
+	AttributeKeyCategory     = "category"
+	AttributeKeyDenom        = "denom"
+	AttributeKeyLimit        = "limit"
+	AttributeKeyWindowBlocks = "window_blocks"
+	AttributeKeyNetOutflow   = "net_outflow"
+)
+
+// NewRewardSplitEvent describes the share of a block's rewards sent to a
+// RewardSplit destination.
+func NewRewardSplitEvent(split RewardSplit, amount sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeRewardSplit,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyDestination, split.Destination),
+		sdk.NewAttribute(AttributeKeyWeight, split.Weight.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewPoolFlowEvent describes the amount that flowed into or out of a pool for
+// one category during a block.
+func NewPoolFlowEvent(flow PoolFlow) sdk.Event {
+	return sdk.NewEvent(
+		EventTypePoolFlow,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyPool, flow.Pool),
+		sdk.NewAttribute(AttributeKeyDirection, flow.Direction),
+		sdk.NewAttribute(AttributeKeyCategory, flow.Category),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, flow.Amount.String()),
+	)
+}
+
+// NewOutflowLimitTrippedEvent describes a send refused because it would have
+// brought the net outflow of a denom to netOutflow, over its limit.
+func NewOutflowLimitTrippedEvent(limit OutflowLimit, netOutflow sdkmath.Int) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeOutflowLimitTripped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyDenom, limit.Denom),
+		sdk.NewAttribute(AttributeKeyLimit, limit.Amount.String()),
+		sdk.NewAttribute(AttributeKeyWindowBlocks, strconv.FormatInt(limit.WindowBlocks, 10)),
+		sdk.NewAttribute(AttributeKeyNetOutflow, netOutflow.String()),
+	)
+}
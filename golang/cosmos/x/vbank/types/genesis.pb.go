@@ -29,6 +29,16 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// state is the current operation state.
 	State State `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// holds are the active escrow holds.
+	Holds []Hold `protobuf:"bytes,3,rep,name=holds,proto3" json:"holds"`
+	// pool_flows are the cumulative flows of the accounted pools.
+	PoolFlows []PoolFlow `protobuf:"bytes,4,rep,name=pool_flows,json=poolFlows,proto3" json:"pool_flows"`
+	// watches are the VM's registrations for balance updates.
+	Watches []Watch `protobuf:"bytes,5,rep,name=watches,proto3" json:"watches"`
+	// snapshot_addresses are the addresses registered for balance snapshots.
+	SnapshotAddresses []string `protobuf:"bytes,6,rep,name=snapshot_addresses,json=snapshotAddresses,proto3" json:"snapshot_addresses,omitempty"`
+	// balance_snapshots are the recorded snapshots of the registered addresses.
+	BalanceSnapshots []BalanceSnapshot `protobuf:"bytes,7,rep,name=balance_snapshots,json=balanceSnapshots,proto3" json:"balance_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +88,41 @@ func (m *GenesisState) GetState() State {
 	return State{}
 }
 
+func (m *GenesisState) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolFlows() []PoolFlow {
+	if m != nil {
+		return m.PoolFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWatches() []Watch {
+	if m != nil {
+		return m.Watches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSnapshotAddresses() []string {
+	if m != nil {
+		return m.SnapshotAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBalanceSnapshots() []BalanceSnapshot {
+	if m != nil {
+		return m.BalanceSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "agoric.vbank.GenesisState")
 }
@@ -85,22 +130,31 @@ func init() {
 func init() { proto.RegisterFile("agoric/vbank/genesis.proto", fileDescriptor_8aaac686f3bede01) }
 
 var fileDescriptor_8aaac686f3bede01 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x4c, 0xcf, 0x2f,
-	0xca, 0x4c, 0xd6, 0x2f, 0x4b, 0x4a, 0xcc, 0xcb, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0xc8, 0xe9, 0x81, 0xe5, 0xa4, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x12, 0xfa, 0x20, 0x16, 0x44, 0x8d, 0x94, 0x04, 0x8a, 0x7e, 0x30, 0x09,
-	0x91, 0x51, 0xaa, 0xe4, 0xe2, 0x71, 0x87, 0x18, 0x17, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc4,
-	0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2,
-	0x87, 0x6c, 0xbc, 0x5e, 0x00, 0x58, 0xce, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x4a,
-	0x21, 0x7d, 0x2e, 0xd6, 0x62, 0x90, 0x66, 0x09, 0x26, 0xb0, 0x16, 0x61, 0x54, 0x2d, 0x60, 0x73,
-	0xa1, 0x3a, 0x20, 0xea, 0xac, 0x58, 0x5e, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3a, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0x7d, 0x47, 0x88, 0xcb, 0x21, 0x46, 0xea, 0x16, 0xa7, 0x64, 0xeb, 0xa7, 0xe7, 0xe7,
-	0x24, 0xe6, 0xa5, 0xeb, 0x27, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0x57, 0x40, 0x3d, 0x55, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x95, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x20, 0xd8,
-	0x19, 0xc8, 0x31, 0x01, 0x00, 0x00,
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcd, 0xce, 0xd2, 0x40,
+	0x14, 0x86, 0x5b, 0xcb, 0x4f, 0x18, 0x58, 0xc8, 0x48, 0xcc, 0x84, 0xc4, 0xd2, 0xb8, 0x62, 0x43,
+	0x27, 0x81, 0x8d, 0xd1, 0x15, 0x2c, 0xd4, 0x25, 0x81, 0x85, 0x89, 0x1b, 0x32, 0x6d, 0xc7, 0x96,
+	0x30, 0xf4, 0x34, 0x3d, 0x55, 0xf4, 0x2e, 0xbc, 0x00, 0x17, 0x5e, 0x0e, 0x4b, 0x96, 0xae, 0x8c,
+	0x81, 0x8d, 0x97, 0x61, 0x98, 0x99, 0x26, 0xf6, 0xfb, 0x36, 0x4d, 0xdb, 0xe7, 0x7d, 0xce, 0x7b,
+	0x92, 0x43, 0xc6, 0x22, 0x85, 0x72, 0x1f, 0xf3, 0x2f, 0x91, 0xc8, 0x0f, 0x3c, 0x95, 0xb9, 0xc4,
+	0x3d, 0x86, 0x45, 0x09, 0x15, 0xd0, 0x81, 0x61, 0xa1, 0x66, 0xe3, 0x51, 0x0a, 0x29, 0x68, 0xc0,
+	0xef, 0x6f, 0x26, 0x33, 0x66, 0x0d, 0x5f, 0x3f, 0x0d, 0x79, 0xf9, 0xc3, 0x23, 0x83, 0x77, 0x66,
+	0xde, 0xb6, 0x12, 0x95, 0xa4, 0x73, 0xd2, 0x29, 0x44, 0x29, 0x8e, 0xc8, 0xdc, 0xc0, 0x9d, 0xf6,
+	0xe7, 0xa3, 0xf0, 0xff, 0xf9, 0xe1, 0x5a, 0xb3, 0x55, 0xeb, 0xfc, 0x7b, 0xe2, 0x6c, 0x6c, 0x92,
+	0x72, 0xd2, 0xc6, 0xbb, 0xcc, 0x9e, 0x68, 0xe5, 0x59, 0x53, 0xd1, 0x73, 0xad, 0x61, 0x72, 0x34,
+	0x24, 0xed, 0x0c, 0x54, 0x82, 0xcc, 0x0b, 0xbc, 0x69, 0x7f, 0x4e, 0x9b, 0xc2, 0x7b, 0x50, 0x49,
+	0x9d, 0xd7, 0x31, 0xfa, 0x86, 0x90, 0x02, 0x40, 0xed, 0x3e, 0x29, 0x38, 0x21, 0x6b, 0x69, 0xe9,
+	0xf9, 0x83, 0xc5, 0x00, 0xd4, 0x5b, 0x05, 0x27, 0x2b, 0xf6, 0x0a, 0xfb, 0x8d, 0x74, 0x41, 0xba,
+	0x27, 0x51, 0xc5, 0x99, 0x44, 0xd6, 0x0e, 0xbc, 0xc7, 0xfb, 0x7d, 0xb8, 0x43, 0xab, 0xd5, 0x49,
+	0x3a, 0x23, 0x14, 0x73, 0x51, 0x60, 0x06, 0xd5, 0x4e, 0x24, 0x49, 0x29, 0x11, 0x25, 0xb2, 0x4e,
+	0xe0, 0x4d, 0x7b, 0x9b, 0x61, 0x4d, 0x96, 0x35, 0xa0, 0x6b, 0x32, 0x8c, 0x84, 0x12, 0x79, 0x2c,
+	0x77, 0x35, 0x44, 0xd6, 0xd5, 0x6d, 0x2f, 0x9a, 0x6d, 0x2b, 0x13, 0xdb, 0xda, 0x94, 0xed, 0x7d,
+	0x1a, 0x35, 0x7f, 0xe3, 0xeb, 0xd6, 0xdf, 0x9f, 0x13, 0x67, 0xb5, 0x39, 0x5f, 0x7d, 0xf7, 0x72,
+	0xf5, 0xdd, 0x3f, 0x57, 0xdf, 0xfd, 0x7e, 0xf3, 0x9d, 0xcb, 0xcd, 0x77, 0x7e, 0xdd, 0x7c, 0xe7,
+	0xe3, 0xab, 0x74, 0x5f, 0x65, 0x9f, 0xa3, 0x30, 0x86, 0x23, 0x5f, 0x9a, 0xeb, 0x9a, 0x9e, 0x19,
+	0x26, 0x07, 0x9e, 0x82, 0x12, 0x79, 0xca, 0x63, 0xc0, 0x23, 0x20, 0xff, 0x6a, 0x0f, 0x5f, 0x7d,
+	0x2b, 0x24, 0x46, 0x1d, 0x7d, 0xf9, 0xc5, 0xbf, 0x01, 0x00, 0xb3, 0x47, 0xef, 0x46, 0x55, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +177,71 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BalanceSnapshots) > 0 {
+		for iNdEx := len(m.BalanceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SnapshotAddresses) > 0 {
+		for iNdEx := len(m.SnapshotAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SnapshotAddresses[iNdEx])
+			copy(dAtA[i:], m.SnapshotAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SnapshotAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Watches) > 0 {
+		for iNdEx := len(m.Watches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Watches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PoolFlows) > 0 {
+		for iNdEx := len(m.PoolFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -167,6 +286,36 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolFlows) > 0 {
+		for _, e := range m.PoolFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Watches) > 0 {
+		for _, e := range m.Watches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SnapshotAddresses) > 0 {
+		for _, s := range m.SnapshotAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BalanceSnapshots) > 0 {
+		for _, e := range m.BalanceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -271,6 +420,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolFlows = append(m.PoolFlows, PoolFlow{})
+			if err := m.PoolFlows[len(m.PoolFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Watches = append(m.Watches, Watch{})
+			if err := m.Watches[len(m.Watches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotAddresses = append(m.SnapshotAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceSnapshots = append(m.BalanceSnapshots, BalanceSnapshot{})
+			if err := m.BalanceSnapshots[len(m.BalanceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless validation of a Hold.
+func (h Hold) ValidateBasic() error {
+	if len(h.Id) == 0 {
+		return fmt.Errorf("hold id cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(h.Owner); err != nil {
+		return fmt.Errorf("hold %q has invalid owner %s: %s", h.Id, h.Owner, err)
+	}
+	if err := h.Coins.Validate(); err != nil {
+		return fmt.Errorf("hold %q has invalid coins: %s", h.Id, err)
+	}
+	if !h.Coins.IsAllPositive() {
+		return fmt.Errorf("hold %q has no coins", h.Id)
+	}
+	if h.ExpiryTime < 0 {
+		return fmt.Errorf("hold %q has negative expiry time %d", h.Id, h.ExpiryTime)
+	}
+	return nil
+}
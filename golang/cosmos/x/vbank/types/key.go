@@ -13,4 +13,17 @@ const (
 	ReservePoolName   = "vbank/reserve"
 	GiveawayPoolName  = "vbank/giveaway"
 	ProvisionPoolName = "vbank/provision"
+	// HoldPoolName is the module account that escrows held coins.
+	HoldPoolName = "vbank/hold"
+
+	// WatchAllDenoms is the denom of a watch on every denomination of an
+	// address.
+	WatchAllDenoms = "*"
+
+	// PoolFlowIn and PoolFlowOut are the directions of a PoolFlow.
+	PoolFlowIn  = "in"
+	PoolFlowOut = "out"
 )
+
+// AccountedPools are the module accounts whose flows are tracked by category.
+var AccountedPools = []string{ProvisionPoolName, ReservePoolName}
@@ -1,3 +1,28 @@
 package types
 
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktypeserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 const RouterKey = ModuleName // this was defined in your key.go file
+
+var _ sdk.Msg = &MsgSetOutflowLimit{}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgSetOutflowLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdktypeserrors.ErrInvalidAddress, "invalid authority: %s", err)
+	}
+	if msg.Limit.Amount.IsNil() || msg.Limit.Amount.IsZero() {
+		if err := sdk.ValidateDenom(msg.Limit.Denom); err != nil {
+			return sdkerrors.Wrapf(sdktypeserrors.ErrInvalidRequest, "invalid denom %s: %s", msg.Limit.Denom, err)
+		}
+		return nil
+	}
+	if err := msg.Limit.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdktypeserrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
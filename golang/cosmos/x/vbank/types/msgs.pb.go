@@ -6,11 +6,18 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -24,20 +31,131 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgSetOutflowLimit sets the outflow limit of limit.denom in the params,
+// removing it if limit.amount is zero, and restarts the denom's window so that
+// its net outflow is reset.
+type MsgSetOutflowLimit struct {
+	// authority is the address that controls the module (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// limit is the new limit.
+	Limit OutflowLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+}
+
+func (m *MsgSetOutflowLimit) Reset()         { *m = MsgSetOutflowLimit{} }
+func (m *MsgSetOutflowLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOutflowLimit) ProtoMessage()    {}
+func (*MsgSetOutflowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f9d0954f3583404, []int{0}
+}
+func (m *MsgSetOutflowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOutflowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOutflowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOutflowLimit.Merge(m, src)
+}
+func (m *MsgSetOutflowLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOutflowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOutflowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOutflowLimit proto.InternalMessageInfo
+
+func (m *MsgSetOutflowLimit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetOutflowLimit) GetLimit() OutflowLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return OutflowLimit{}
+}
+
+// MsgSetOutflowLimitResponse is an empty reply.
+type MsgSetOutflowLimitResponse struct {
+}
+
+func (m *MsgSetOutflowLimitResponse) Reset()         { *m = MsgSetOutflowLimitResponse{} }
+func (m *MsgSetOutflowLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOutflowLimitResponse) ProtoMessage()    {}
+func (*MsgSetOutflowLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f9d0954f3583404, []int{1}
+}
+func (m *MsgSetOutflowLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOutflowLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOutflowLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOutflowLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOutflowLimitResponse.Merge(m, src)
+}
+func (m *MsgSetOutflowLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOutflowLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOutflowLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOutflowLimitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetOutflowLimit)(nil), "agoric.vbank.MsgSetOutflowLimit")
+	proto.RegisterType((*MsgSetOutflowLimitResponse)(nil), "agoric.vbank.MsgSetOutflowLimitResponse")
+}
+
 func init() { proto.RegisterFile("agoric/vbank/msgs.proto", fileDescriptor_4f9d0954f3583404) }
 
 var fileDescriptor_4f9d0954f3583404 = []byte{
-	// 160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x4c, 0xcf, 0x2f,
-	0xca, 0x4c, 0xd6, 0x2f, 0x4b, 0x4a, 0xcc, 0xcb, 0xd6, 0xcf, 0x2d, 0x4e, 0x2f, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x48, 0xe8, 0x81, 0x25, 0xa4, 0xc4, 0x93, 0xf3, 0x8b, 0x73,
-	0xf3, 0x8b, 0x41, 0x0a, 0xf4, 0xcb, 0x0c, 0x41, 0x14, 0x44, 0x99, 0x11, 0x0f, 0x17, 0xb3, 0x6f,
-	0x71, 0xba, 0x14, 0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0x41, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
-	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31,
-	0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f,
-	0xab, 0xef, 0x08, 0xb1, 0x12, 0x62, 0x81, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x7a, 0x7e, 0x4e, 0x62,
-	0x5e, 0xba, 0x3e, 0xd4, 0x92, 0x0a, 0xa8, 0x6b, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0,
-	0x16, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x8b, 0x6a, 0x41, 0xaa, 0x00, 0x00, 0x00,
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x4f, 0x4b, 0xfb, 0x40,
+	0x10, 0xcd, 0xfe, 0x7e, 0x56, 0xe8, 0x2a, 0x88, 0xa1, 0xd2, 0x18, 0x24, 0x96, 0x9e, 0x42, 0xa1,
+	0x59, 0xac, 0x50, 0xc4, 0x5b, 0x7b, 0xb6, 0x08, 0xe9, 0x4d, 0x10, 0x49, 0xdb, 0xb8, 0x5d, 0xda,
+	0x64, 0x4a, 0x76, 0x5b, 0xed, 0x4d, 0x3c, 0x7a, 0xf2, 0x93, 0x48, 0x0f, 0x7e, 0x88, 0x1e, 0x8b,
+	0x27, 0x4f, 0x22, 0xed, 0x21, 0x5f, 0x43, 0x92, 0x5d, 0xe9, 0xbf, 0x83, 0x97, 0xd9, 0x9d, 0x79,
+	0x6f, 0xdf, 0xbc, 0xd9, 0xc1, 0x79, 0x8f, 0x42, 0xc4, 0xda, 0x64, 0xd4, 0xf2, 0xc2, 0x1e, 0x09,
+	0x38, 0xe5, 0xce, 0x20, 0x02, 0x01, 0xfa, 0xbe, 0x04, 0x9c, 0x14, 0x30, 0x0f, 0xbd, 0x80, 0x85,
+	0x40, 0xd2, 0x28, 0x09, 0x66, 0xbe, 0x0d, 0x3c, 0x00, 0x9e, 0xbc, 0x21, 0xa3, 0xb3, 0xe4, 0x50,
+	0xc0, 0xb1, 0x04, 0xee, 0xd2, 0x8c, 0xc8, 0x44, 0x41, 0x39, 0x0a, 0x14, 0x64, 0x3d, 0xb9, 0xa9,
+	0xaa, 0xb1, 0xe6, 0x21, 0x8d, 0x12, 0x29, 0xbe, 0x21, 0xac, 0x37, 0x38, 0x6d, 0xfa, 0xe2, 0x7a,
+	0x28, 0xee, 0xfb, 0xf0, 0x70, 0xc5, 0x02, 0x26, 0xf4, 0x2a, 0xce, 0x7a, 0x43, 0xd1, 0x85, 0x88,
+	0x89, 0xb1, 0x81, 0x0a, 0xc8, 0xce, 0xd6, 0x8d, 0x8f, 0xf7, 0x72, 0x4e, 0xf5, 0xaa, 0x75, 0x3a,
+	0x91, 0xcf, 0x79, 0x53, 0x44, 0x2c, 0xa4, 0xee, 0x92, 0xaa, 0x57, 0x71, 0xa6, 0x9f, 0x08, 0x18,
+	0xff, 0x0a, 0xc8, 0xde, 0xab, 0x98, 0xce, 0xea, 0x8c, 0xce, 0x6a, 0x8b, 0xfa, 0xce, 0xf4, 0xeb,
+	0x54, 0x73, 0x25, 0xfd, 0xd2, 0x7e, 0x8e, 0x27, 0xa5, 0xa5, 0xce, 0x4b, 0x3c, 0x29, 0x1d, 0x49,
+	0xb3, 0x1b, 0xce, 0x8a, 0x27, 0xd8, 0xdc, 0xf6, 0xeb, 0xfa, 0x7c, 0x00, 0x21, 0xf7, 0x2b, 0x3d,
+	0xfc, 0xbf, 0xc1, 0xa9, 0x7e, 0x8b, 0x0f, 0x36, 0x27, 0x2a, 0xac, 0x5b, 0xd9, 0xd6, 0x30, 0xed,
+	0xbf, 0x18, 0xbf, 0x5d, 0xcc, 0xcc, 0x53, 0x3c, 0x29, 0xa1, 0xba, 0x3b, 0x9d, 0x5b, 0x68, 0x36,
+	0xb7, 0xd0, 0xf7, 0xdc, 0x42, 0xaf, 0x0b, 0x4b, 0x9b, 0x2d, 0x2c, 0xed, 0x73, 0x61, 0x69, 0x37,
+	0x17, 0x94, 0x89, 0xee, 0xb0, 0xe5, 0xb4, 0x21, 0x20, 0x35, 0xf9, 0xf5, 0x52, 0xbb, 0xcc, 0x3b,
+	0x3d, 0x42, 0xa1, 0xef, 0x85, 0x54, 0xed, 0x8d, 0x3c, 0xaa, 0xad, 0x88, 0xf1, 0xc0, 0xe7, 0xad,
+	0xdd, 0x74, 0x2d, 0xe7, 0x3f, 0x03, 0x00, 0x72, 0xf8, 0xd2, 0x3f, 0x36, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -52,6 +170,8 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// Set or remove the outflow limit of a denom, restarting its window.
+	SetOutflowLimit(ctx context.Context, in *MsgSetOutflowLimit, opts ...grpc.CallOption) (*MsgSetOutflowLimitResponse, error)
 }
 
 type msgClient struct {
@@ -62,23 +182,415 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) SetOutflowLimit(ctx context.Context, in *MsgSetOutflowLimit, opts ...grpc.CallOption) (*MsgSetOutflowLimitResponse, error) {
+	out := new(MsgSetOutflowLimitResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Msg/SetOutflowLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// Set or remove the outflow limit of a denom, restarting its window.
+	SetOutflowLimit(context.Context, *MsgSetOutflowLimit) (*MsgSetOutflowLimitResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) SetOutflowLimit(ctx context.Context, req *MsgSetOutflowLimit) (*MsgSetOutflowLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOutflowLimit not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_SetOutflowLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOutflowLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOutflowLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Msg/SetOutflowLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOutflowLimit(ctx, req.(*MsgSetOutflowLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "agoric.vbank.Msg",
 	HandlerType: (*MsgServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "agoric/vbank/msgs.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetOutflowLimit",
+			Handler:    _Msg_SetOutflowLimit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/vbank/msgs.proto",
+}
+
+func (m *MsgSetOutflowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOutflowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOutflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
+
+func (m *MsgSetOutflowLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOutflowLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOutflowLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetOutflowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSetOutflowLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetOutflowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOutflowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOutflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOutflowLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOutflowLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOutflowLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgs = fmt.Errorf("proto: unexpected end of group")
+)
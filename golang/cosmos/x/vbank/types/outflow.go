@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless validation of an OutflowLimit.
+func (l OutflowLimit) ValidateBasic() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("outflow limit has invalid denom %s: %s", l.Denom, err)
+	}
+	if l.Amount.IsNil() || !l.Amount.IsPositive() {
+		return fmt.Errorf("outflow limit of %s must be positive: %s", l.Denom, l.Amount)
+	}
+	if l.WindowBlocks <= 0 {
+		return fmt.Errorf("outflow limit of %s must have a positive window: %d", l.Denom, l.WindowBlocks)
+	}
+	return nil
+}
+
+// SetOutflowLimit returns limits with the limit of limit.Denom replaced by (or
+// extended with) limit, or removed if limit.Amount is zero or unset.
+func SetOutflowLimit(limits []OutflowLimit, limit OutflowLimit) []OutflowLimit {
+	if limit.Amount.IsNil() {
+		limit.Amount = sdkmath.ZeroInt()
+	}
+	updated := make([]OutflowLimit, 0, len(limits)+1)
+	replaced := false
+	for _, l := range limits {
+		if l.Denom != limit.Denom {
+			updated = append(updated, l)
+			continue
+		}
+		replaced = true
+		if !limit.Amount.IsZero() {
+			updated = append(updated, limit)
+		}
+	}
+	if !replaced && !limit.Amount.IsZero() {
+		updated = append(updated, limit)
+	}
+	return updated
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -13,12 +14,44 @@ import (
 
 const AllowAllMonitoringAccountsPattern = "*"
 
+// RewardSplitCommunityPool is the RewardSplit destination for the community
+// pool of the distribution module.
+const RewardSplitCommunityPool = "community_pool"
+
+// RewardSplitDestinations are the only allowed RewardSplit destinations.  Other
+// module accounts (such as the hold pool, whose balance must match its holds)
+// cannot receive rewards.
+var RewardSplitDestinations = []string{
+	RewardSplitCommunityPool,
+	authtypes.FeeCollectorName,
+	ReservePoolName,
+	GiveawayPoolName,
+}
+
+// DefaultRewardHistoryEpochs is the default number of reward epochs kept in
+// the reward history.
+const DefaultRewardHistoryEpochs = 1000
+
+// DefaultMaxSnapshotAddresses is the default number of addresses that may be
+// registered for balance snapshots.
+const DefaultMaxSnapshotAddresses = 1000
+
+// DefaultSnapshotRetentionBlocks is the default number of blocks for which
+// balance snapshots are kept.
+const DefaultSnapshotRetentionBlocks = 100000
+
 // Parameter keys
 var (
 	ParamStoreKeyRewardEpochDurationBlocks = []byte("reward_epoch_duration_blocks")
 	ParamStoreKeyRewardSmoothingBlocks     = []byte("reward_smoothing_blocks")
 	ParamStoreKeyPerEpochRewardFraction    = []byte("per_epoch_reward_fraction")
 	ParamStoreKeyAllowedMonitoringAccounts = []byte("allowed_monitoring_accounts")
+	ParamStoreKeyRewardHistoryEpochs       = []byte("reward_history_epochs")
+	ParamStoreKeyRewardSplits              = []byte("reward_splits")
+	ParamStoreKeyOutflowLimits             = []byte("outflow_limits")
+	ParamStoreKeySnapshotIntervalBlocks    = []byte("snapshot_interval_blocks")
+	ParamStoreKeyMaxSnapshotAddresses      = []byte("max_snapshot_addresses")
+	ParamStoreKeySnapshotRetentionBlocks   = []byte("snapshot_retention_blocks")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -34,6 +67,9 @@ func DefaultParams() Params {
 		RewardSmoothingBlocks:     1,
 		PerEpochRewardFraction:    sdkmath.LegacyOneDec(),
 		AllowedMonitoringAccounts: []string{provisionAddress.String()},
+		RewardHistoryEpochs:       DefaultRewardHistoryEpochs,
+		MaxSnapshotAddresses:      DefaultMaxSnapshotAddresses,
+		SnapshotRetentionBlocks:   DefaultSnapshotRetentionBlocks,
 	}
 }
 
@@ -95,6 +131,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyRewardSmoothingBlocks, &p.RewardSmoothingBlocks, validateRewardSmoothingBlocks),
 		paramtypes.NewParamSetPair(ParamStoreKeyPerEpochRewardFraction, &p.PerEpochRewardFraction, validatePerEpochRewardFraction),
 		paramtypes.NewParamSetPair(ParamStoreKeyAllowedMonitoringAccounts, &p.AllowedMonitoringAccounts, validateAllowedMonitoringAccounts),
+		paramtypes.NewParamSetPair(ParamStoreKeyRewardHistoryEpochs, &p.RewardHistoryEpochs, validateRewardHistoryEpochs),
+		paramtypes.NewParamSetPair(ParamStoreKeyRewardSplits, &p.RewardSplits, validateRewardSplits),
+		paramtypes.NewParamSetPair(ParamStoreKeyOutflowLimits, &p.OutflowLimits, validateOutflowLimits),
+		paramtypes.NewParamSetPair(ParamStoreKeySnapshotIntervalBlocks, &p.SnapshotIntervalBlocks, validateSnapshotIntervalBlocks),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSnapshotAddresses, &p.MaxSnapshotAddresses, validateMaxSnapshotAddresses),
+		paramtypes.NewParamSetPair(ParamStoreKeySnapshotRetentionBlocks, &p.SnapshotRetentionBlocks, validateSnapshotRetentionBlocks),
 	}
 }
 
@@ -112,6 +154,24 @@ func (p Params) ValidateBasic() error {
 	if err := validateAllowedMonitoringAccounts(p.AllowedMonitoringAccounts); err != nil {
 		return err
 	}
+	if err := validateRewardHistoryEpochs(p.RewardHistoryEpochs); err != nil {
+		return err
+	}
+	if err := validateRewardSplits(p.RewardSplits); err != nil {
+		return err
+	}
+	if err := validateOutflowLimits(p.OutflowLimits); err != nil {
+		return err
+	}
+	if err := validateSnapshotIntervalBlocks(p.SnapshotIntervalBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxSnapshotAddresses(p.MaxSnapshotAddresses); err != nil {
+		return err
+	}
+	if err := validateSnapshotRetentionBlocks(p.SnapshotRetentionBlocks); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -172,3 +232,110 @@ func validateAllowedMonitoringAccounts(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardHistoryEpochs(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("reward history epochs must be nonnegative: %d", v)
+	}
+
+	return nil
+}
+
+func validateRewardSplits(i interface{}) error {
+	v, ok := i.([]RewardSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) == 0 {
+		return nil
+	}
+
+	total := sdkmath.LegacyZeroDec()
+	seen := make(map[string]bool, len(v))
+	for s, split := range v {
+		if split.Destination == "" {
+			return fmt.Errorf("reward splits element[%d] destination cannot be empty", s)
+		}
+		if !slices.Contains(RewardSplitDestinations, split.Destination) {
+			return fmt.Errorf("reward splits element[%d] destination %q is not one of %q", s, split.Destination, RewardSplitDestinations)
+		}
+		if seen[split.Destination] {
+			return fmt.Errorf("reward splits element[%d] destination %q is duplicated", s, split.Destination)
+		}
+		seen[split.Destination] = true
+		if split.Weight.IsNil() || !split.Weight.IsPositive() {
+			return fmt.Errorf("reward splits element[%d] weight must be positive: %s", s, split.Weight)
+		}
+		total = total.Add(split.Weight)
+	}
+
+	if !total.Equal(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("reward split weights must sum to one: %s", total)
+	}
+
+	return nil
+}
+
+func validateOutflowLimits(i interface{}) error {
+	v, ok := i.([]OutflowLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for l, limit := range v {
+		if err := limit.ValidateBasic(); err != nil {
+			return fmt.Errorf("outflow limits element[%d]: %s", l, err)
+		}
+		if seen[limit.Denom] {
+			return fmt.Errorf("outflow limits element[%d] denom %q is duplicated", l, limit.Denom)
+		}
+		seen[limit.Denom] = true
+	}
+
+	return nil
+}
+
+func validateSnapshotIntervalBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("snapshot interval blocks must be nonnegative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxSnapshotAddresses(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max snapshot addresses must be nonnegative: %d", v)
+	}
+
+	return nil
+}
+
+func validateSnapshotRetentionBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("snapshot retention blocks must be nonnegative: %d", v)
+	}
+
+	return nil
+}
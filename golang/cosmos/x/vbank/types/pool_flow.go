@@ -0,0 +1,23 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ValidateBasic performs stateless validation of a PoolFlow.
+func (f PoolFlow) ValidateBasic() error {
+	if !slices.Contains(AccountedPools, f.Pool) {
+		return fmt.Errorf("pool %q is not accounted", f.Pool)
+	}
+	if f.Direction != PoolFlowIn && f.Direction != PoolFlowOut {
+		return fmt.Errorf("pool %q flow has invalid direction %q", f.Pool, f.Direction)
+	}
+	if len(f.Category) == 0 {
+		return fmt.Errorf("pool %q flow %s has no category", f.Pool, f.Direction)
+	}
+	if err := f.Amount.Validate(); err != nil {
+		return fmt.Errorf("pool %q flow %s %s has invalid amount: %s", f.Pool, f.Direction, f.Category, err)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -195,332 +197,2777 @@ func (m *QueryStateResponse) GetState() State {
 	return State{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.vbank.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.vbank.QueryParamsResponse")
-	proto.RegisterType((*QueryStateRequest)(nil), "agoric.vbank.QueryStateRequest")
-	proto.RegisterType((*QueryStateResponse)(nil), "agoric.vbank.QueryStateResponse")
+// QueryHoldsRequest is the request type for the Query/Holds RPC method.
+type QueryHoldsRequest struct {
+	// address is the owner of the holds.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("agoric/vbank/query.proto", fileDescriptor_f70e65583c8f2384) }
-
-var fileDescriptor_f70e65583c8f2384 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0x4f, 0x5f, 0x3b, 0x18, 0x16, 0x9c, 0x80, 0xaa, 0x50, 0xb9, 0x25, 0x13, 0x0b,
-	0xb1, 0x54, 0x16, 0x56, 0x2a, 0x31, 0xb0, 0x41, 0xd9, 0xd8, 0xdc, 0x62, 0x99, 0xa8, 0xad, 0x6f,
-	0x1a, 0xbb, 0x88, 0xae, 0x3c, 0x01, 0x12, 0x2f, 0xd5, 0xb1, 0x12, 0x0b, 0x0b, 0x08, 0xb5, 0x3c,
-	0x08, 0xaa, 0x6d, 0xa4, 0x5a, 0xfc, 0x59, 0xa2, 0xe8, 0x9e, 0x73, 0x7f, 0xe7, 0x9e, 0x04, 0x37,
-	0xb8, 0x84, 0xaa, 0x18, 0xb0, 0xbb, 0x3e, 0x57, 0x43, 0x36, 0x99, 0x8a, 0x6a, 0x96, 0x97, 0x15,
-	0x18, 0x20, 0xdb, 0x4e, 0xc9, 0xad, 0x92, 0x26, 0x12, 0x24, 0x58, 0x81, 0xad, 0xdf, 0x9c, 0x27,
-	0x6d, 0x4a, 0x00, 0x39, 0x12, 0x8c, 0x97, 0x05, 0xe3, 0x4a, 0x81, 0xe1, 0xa6, 0x00, 0xa5, 0xbd,
-	0x1a, 0xb2, 0xed, 0xd3, 0x29, 0x59, 0x82, 0xc9, 0xe5, 0x3a, 0xea, 0x82, 0x57, 0x7c, 0xac, 0x7b,
-	0x62, 0x32, 0x15, 0xda, 0x64, 0xe7, 0x38, 0x0e, 0xa6, 0xba, 0x04, 0xa5, 0x05, 0xe9, 0xe0, 0x7a,
-	0x69, 0x27, 0x0d, 0xd4, 0x46, 0x87, 0x5b, 0x9d, 0x24, 0xdf, 0xbc, 0x2c, 0x77, 0xee, 0xee, 0xff,
-	0xf9, 0x5b, 0x2b, 0xea, 0x79, 0x67, 0x16, 0xe3, 0x1d, 0x8b, 0xba, 0x32, 0xdc, 0x88, 0x2f, 0xfe,
-	0x99, 0x4f, 0xf5, 0x43, 0x8f, 0x67, 0xb8, 0xa6, 0xd7, 0x03, 0x4f, 0x8f, 0x43, 0xba, 0xf5, 0x7a,
-	0xb8, 0xf3, 0x75, 0x5e, 0x11, 0xae, 0x59, 0x0e, 0x19, 0xe2, 0xba, 0x4b, 0x27, 0xed, 0x70, 0xeb,
-	0x7b, 0xb9, 0xf4, 0xe0, 0x0f, 0x87, 0xbb, 0x24, 0x6b, 0x3e, 0x3c, 0x7f, 0x3c, 0xfd, 0xdb, 0x23,
-	0x09, 0x0b, 0x3e, 0x9c, 0xab, 0x44, 0x24, 0xae, 0xd9, 0x63, 0x48, 0xeb, 0x07, 0xd2, 0x66, 0xcf,
-	0xb4, 0xfd, 0xbb, 0xc1, 0x27, 0xed, 0xdb, 0xa4, 0x5d, 0x12, 0x87, 0x49, 0xb6, 0x5f, 0xb7, 0x37,
-	0x5f, 0x52, 0xb4, 0x58, 0x52, 0xf4, 0xbe, 0xa4, 0xe8, 0x71, 0x45, 0xa3, 0xc5, 0x8a, 0x46, 0x2f,
-	0x2b, 0x1a, 0x5d, 0x9f, 0xc8, 0xc2, 0xdc, 0x4e, 0xfb, 0xf9, 0x00, 0xc6, 0xec, 0xd4, 0x2d, 0xba,
-	0xfd, 0x23, 0x7d, 0x33, 0x64, 0x12, 0x46, 0x5c, 0x49, 0x36, 0x00, 0x3d, 0x06, 0xcd, 0xee, 0x3d,
-	0xd3, 0xcc, 0x4a, 0xa1, 0xfb, 0x75, 0xfb, 0xdf, 0x8f, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x35, 0x34, 0x38, 0x6f, 0x02, 0x00, 0x00,
+func (m *QueryHoldsRequest) Reset()         { *m = QueryHoldsRequest{} }
+func (m *QueryHoldsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldsRequest) ProtoMessage()    {}
+func (*QueryHoldsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{4}
+}
+func (m *QueryHoldsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHoldsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldsRequest.Merge(m, src)
+}
+func (m *QueryHoldsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHoldsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryHoldsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the vbank module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// State queries current state of the vbank module.
-	State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+func (m *QueryHoldsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryHoldsResponse is the response type for the Query/Holds RPC method.
+type QueryHoldsResponse struct {
+	// holds are the active holds of the address, ordered by id.
+	Holds []Hold `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryHoldsResponse) Reset()         { *m = QueryHoldsResponse{} }
+func (m *QueryHoldsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldsResponse) ProtoMessage()    {}
+func (*QueryHoldsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{5}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHoldsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHoldsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldsResponse.Merge(m, src)
+}
+func (m *QueryHoldsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHoldsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error) {
-	out := new(QueryStateResponse)
-	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/State", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHoldsResponse proto.InternalMessageInfo
+
+func (m *QueryHoldsResponse) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the vbank module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// State queries current state of the vbank module.
-	State(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+// QueryWatchesRequest is the request type for the Query/Watches RPC method.
+type QueryWatchesRequest struct {
+	// address, if nonempty, restricts the watches to those of the address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryWatchesRequest) Reset()         { *m = QueryWatchesRequest{} }
+func (m *QueryWatchesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchesRequest) ProtoMessage()    {}
+func (*QueryWatchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{6}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryWatchesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) State(ctx context.Context, req *QueryStateRequest) (*QueryStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+func (m *QueryWatchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryWatchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchesRequest.Merge(m, src)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.vbank.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryWatchesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchesRequest.DiscardUnknown(m)
 }
 
-func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).State(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/agoric.vbank.Query/State",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).State(ctx, req.(*QueryStateRequest))
+var xxx_messageInfo_QueryWatchesRequest proto.InternalMessageInfo
+
+func (m *QueryWatchesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "agoric.vbank.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "State",
-			Handler:    _Query_State_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "agoric/vbank/query.proto",
+// QueryWatchesResponse is the response type for the Query/Watches RPC method.
+type QueryWatchesResponse struct {
+	// watches are ordered by address and then denom.
+	Watches []Watch `protobuf:"bytes,1,rep,name=watches,proto3" json:"watches"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWatchesResponse) Reset()         { *m = QueryWatchesResponse{} }
+func (m *QueryWatchesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchesResponse) ProtoMessage()    {}
+func (*QueryWatchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{7}
+}
+func (m *QueryWatchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWatchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchesResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryWatchesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWatchesResponse proto.InternalMessageInfo
+
+func (m *QueryWatchesResponse) GetWatches() []Watch {
+	if m != nil {
+		return m.Watches
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardHistoryRequest is the request type for the Query/RewardHistory
+// RPC method.
+type QueryRewardHistoryRequest struct {
+	// limit, if nonzero, is the maximum number of epochs to return.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardHistoryRequest) Reset()         { *m = QueryRewardHistoryRequest{} }
+func (m *QueryRewardHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardHistoryRequest) ProtoMessage()    {}
+func (*QueryRewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{8}
+}
+func (m *QueryRewardHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRewardHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardHistoryRequest.Merge(m, src)
 }
-
-func (m *QueryStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardHistoryRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRewardHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRewardHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardHistoryResponse is the response type for the Query/RewardHistory
+// RPC method.
+type QueryRewardHistoryResponse struct {
+	// epochs are ordered from most to least recent.
+	Epochs []RewardEpoch `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
 }
 
-func (m *QueryStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardHistoryResponse) Reset()         { *m = QueryRewardHistoryResponse{} }
+func (m *QueryRewardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardHistoryResponse) ProtoMessage()    {}
+func (*QueryRewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{9}
+}
+func (m *QueryRewardHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardHistoryResponse.Merge(m, src)
+}
+func (m *QueryRewardHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardHistoryResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryRewardHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRewardHistoryResponse) GetEpochs() []RewardEpoch {
+	if m != nil {
+		return m.Epochs
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryProjectedRewardsRequest is the request type for the
+// Query/ProjectedRewards RPC method.
+type QueryProjectedRewardsRequest struct {
+	// n_blocks is the number of blocks after the current one to simulate.
+	NBlocks int64 `protobuf:"varint,1,opt,name=n_blocks,json=nBlocks,proto3" json:"n_blocks,omitempty"`
+}
+
+func (m *QueryProjectedRewardsRequest) Reset()         { *m = QueryProjectedRewardsRequest{} }
+func (m *QueryProjectedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRewardsRequest) ProtoMessage()    {}
+func (*QueryProjectedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{10}
+}
+func (m *QueryProjectedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRewardsRequest.Merge(m, src)
+}
+func (m *QueryProjectedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedRewardsRequest) GetNBlocks() int64 {
+	if m != nil {
+		return m.NBlocks
+	}
+	return 0
+}
+
+// QueryProjectedRewardsResponse is the response type for the
+// Query/ProjectedRewards RPC method.
+type QueryProjectedRewardsResponse struct {
+	// total is the amount that would be sent to the reward distributor.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// reward_pool is the balance that would remain in the reward pool.
+	RewardPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reward_pool,json=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool"`
+	// epochs are the simulated epochs that distribute rewards, in order.
+	Epochs []RewardEpoch `protobuf:"bytes,3,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *QueryProjectedRewardsResponse) Reset()         { *m = QueryProjectedRewardsResponse{} }
+func (m *QueryProjectedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRewardsResponse) ProtoMessage()    {}
+func (*QueryProjectedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{11}
+}
+func (m *QueryProjectedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRewardsResponse.Merge(m, src)
+}
+func (m *QueryProjectedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *QueryProjectedRewardsResponse) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
+	}
+	return nil
+}
+
+func (m *QueryProjectedRewardsResponse) GetEpochs() []RewardEpoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+// QueryPoolFlowsRequest is the request type for the Query/PoolFlows RPC
+// method.
+type QueryPoolFlowsRequest struct {
+	// pool, if nonempty, restricts the flows to those of the named pool.
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (m *QueryPoolFlowsRequest) Reset()         { *m = QueryPoolFlowsRequest{} }
+func (m *QueryPoolFlowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFlowsRequest) ProtoMessage()    {}
+func (*QueryPoolFlowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{12}
+}
+func (m *QueryPoolFlowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFlowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFlowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolFlowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFlowsRequest.Merge(m, src)
+}
+func (m *QueryPoolFlowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFlowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFlowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolFlowsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolFlowsRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+// QueryPoolFlowsResponse is the response type for the Query/PoolFlows RPC
+// method.
+type QueryPoolFlowsResponse struct {
+	// flows are ordered by pool, direction and then category.
+	Flows []PoolFlow `protobuf:"bytes,1,rep,name=flows,proto3" json:"flows"`
+}
+
+func (m *QueryPoolFlowsResponse) Reset()         { *m = QueryPoolFlowsResponse{} }
+func (m *QueryPoolFlowsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFlowsResponse) ProtoMessage()    {}
+func (*QueryPoolFlowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{13}
+}
+func (m *QueryPoolFlowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFlowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFlowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolFlowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFlowsResponse.Merge(m, src)
+}
+func (m *QueryPoolFlowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFlowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFlowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolFlowsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolFlowsResponse) GetFlows() []PoolFlow {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
+// QueryBalancesAtRequest is the request type for the Query/BalancesAt RPC
+// method.
+type QueryBalancesAtRequest struct {
+	// address is the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the block at whose end the balances are wanted.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBalancesAtRequest) Reset()         { *m = QueryBalancesAtRequest{} }
+func (m *QueryBalancesAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancesAtRequest) ProtoMessage()    {}
+func (*QueryBalancesAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{14}
+}
+func (m *QueryBalancesAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancesAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancesAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancesAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancesAtRequest.Merge(m, src)
+}
+func (m *QueryBalancesAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancesAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancesAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancesAtRequest proto.InternalMessageInfo
+
+func (m *QueryBalancesAtRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryBalancesAtRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBalancesAtResponse is the response type for the Query/BalancesAt RPC
+// method.
+type QueryBalancesAtResponse struct {
+	// snapshot is the last snapshot at or before the height.
+	Snapshot BalanceSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (m *QueryBalancesAtResponse) Reset()         { *m = QueryBalancesAtResponse{} }
+func (m *QueryBalancesAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancesAtResponse) ProtoMessage()    {}
+func (*QueryBalancesAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f70e65583c8f2384, []int{15}
+}
+func (m *QueryBalancesAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancesAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancesAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancesAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancesAtResponse.Merge(m, src)
+}
+func (m *QueryBalancesAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancesAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancesAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancesAtResponse proto.InternalMessageInfo
+
+func (m *QueryBalancesAtResponse) GetSnapshot() BalanceSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return BalanceSnapshot{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.vbank.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.vbank.QueryParamsResponse")
+	proto.RegisterType((*QueryStateRequest)(nil), "agoric.vbank.QueryStateRequest")
+	proto.RegisterType((*QueryStateResponse)(nil), "agoric.vbank.QueryStateResponse")
+	proto.RegisterType((*QueryHoldsRequest)(nil), "agoric.vbank.QueryHoldsRequest")
+	proto.RegisterType((*QueryHoldsResponse)(nil), "agoric.vbank.QueryHoldsResponse")
+	proto.RegisterType((*QueryWatchesRequest)(nil), "agoric.vbank.QueryWatchesRequest")
+	proto.RegisterType((*QueryWatchesResponse)(nil), "agoric.vbank.QueryWatchesResponse")
+	proto.RegisterType((*QueryRewardHistoryRequest)(nil), "agoric.vbank.QueryRewardHistoryRequest")
+	proto.RegisterType((*QueryRewardHistoryResponse)(nil), "agoric.vbank.QueryRewardHistoryResponse")
+	proto.RegisterType((*QueryProjectedRewardsRequest)(nil), "agoric.vbank.QueryProjectedRewardsRequest")
+	proto.RegisterType((*QueryProjectedRewardsResponse)(nil), "agoric.vbank.QueryProjectedRewardsResponse")
+	proto.RegisterType((*QueryPoolFlowsRequest)(nil), "agoric.vbank.QueryPoolFlowsRequest")
+	proto.RegisterType((*QueryPoolFlowsResponse)(nil), "agoric.vbank.QueryPoolFlowsResponse")
+	proto.RegisterType((*QueryBalancesAtRequest)(nil), "agoric.vbank.QueryBalancesAtRequest")
+	proto.RegisterType((*QueryBalancesAtResponse)(nil), "agoric.vbank.QueryBalancesAtResponse")
+}
+
+func init() { proto.RegisterFile("agoric/vbank/query.proto", fileDescriptor_f70e65583c8f2384) }
+
+var fileDescriptor_f70e65583c8f2384 = []byte{
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0x8e, 0x93, 0xda, 0x6e, 0x5f, 0xa8, 0x04, 0x13, 0x27, 0x75, 0x96, 0xc4, 0x31, 0x4b, 0x2b,
+	0xa2, 0xfe, 0xd8, 0x25, 0x2e, 0x12, 0x70, 0x42, 0x35, 0x14, 0x95, 0x1f, 0x87, 0xe2, 0x0a, 0x21,
+	0xf5, 0x62, 0x8d, 0xd7, 0xc3, 0x7a, 0xc9, 0x66, 0xdf, 0x66, 0x67, 0xd2, 0x34, 0x8a, 0x72, 0xe1,
+	0xc4, 0x0d, 0x24, 0xfe, 0x00, 0xce, 0xf0, 0x97, 0xf4, 0x58, 0x89, 0x0b, 0x27, 0x40, 0x09, 0x7f,
+	0x05, 0x27, 0xe4, 0x37, 0x6f, 0x5d, 0xaf, 0xb3, 0x49, 0x8a, 0xd4, 0x8b, 0xbd, 0x33, 0xf3, 0xbd,
+	0xef, 0xfb, 0xfc, 0xf6, 0xcd, 0x27, 0x43, 0x53, 0x86, 0x98, 0x45, 0x81, 0xff, 0x64, 0x20, 0x93,
+	0x6d, 0x7f, 0x77, 0x4f, 0x65, 0x07, 0x5e, 0x9a, 0xa1, 0x41, 0xf1, 0x9a, 0x3d, 0xf1, 0xe8, 0xc4,
+	0x69, 0x84, 0x18, 0x22, 0x1d, 0xf8, 0xe3, 0x27, 0x8b, 0x71, 0xd6, 0x42, 0xc4, 0x30, 0x56, 0xbe,
+	0x4c, 0x23, 0x5f, 0x26, 0x09, 0x1a, 0x69, 0x22, 0x4c, 0x34, 0x9f, 0x16, 0xb9, 0xe9, 0x93, 0x4f,
+	0x5a, 0x01, 0xea, 0x1d, 0xd4, 0xfe, 0x40, 0x6a, 0xe5, 0x3f, 0xd9, 0x1a, 0x28, 0x23, 0xb7, 0xfc,
+	0x00, 0xa3, 0xc4, 0x9e, 0xbb, 0x0d, 0x10, 0x5f, 0x8d, 0xad, 0x3c, 0x94, 0x99, 0xdc, 0xd1, 0x3d,
+	0xb5, 0xbb, 0xa7, 0xb4, 0x71, 0x3f, 0x83, 0xa5, 0xc2, 0xae, 0x4e, 0x31, 0xd1, 0x4a, 0x74, 0xa0,
+	0x96, 0xd2, 0x4e, 0xb3, 0xd2, 0xae, 0x6c, 0x2e, 0x76, 0x1a, 0xde, 0xb4, 0x73, 0xcf, 0xa2, 0xbb,
+	0x97, 0x9e, 0xfd, 0xb9, 0x31, 0xd7, 0x63, 0xa4, 0xbb, 0x04, 0x6f, 0x10, 0xd5, 0x23, 0x23, 0x8d,
+	0xca, 0xf9, 0xef, 0x83, 0x98, 0xde, 0x64, 0x7a, 0x1f, 0xaa, 0x7a, 0xbc, 0xc1, 0xec, 0x4b, 0x45,
+	0x76, 0xc2, 0x32, 0xb9, 0xc5, 0xb9, 0x77, 0x98, 0xfb, 0x01, 0xc6, 0xc3, 0xdc, 0xbb, 0x68, 0x42,
+	0x5d, 0x0e, 0x87, 0x99, 0xd2, 0xd6, 0xe5, 0x95, 0x5e, 0xbe, 0x74, 0x3f, 0x01, 0x31, 0x0d, 0x67,
+	0x55, 0x0f, 0xaa, 0xa3, 0xf1, 0x46, 0xb3, 0xd2, 0x5e, 0xd8, 0x5c, 0xec, 0x88, 0xa2, 0xea, 0x18,
+	0x9b, 0x8b, 0x12, 0xcc, 0xf5, 0xb9, 0x37, 0xdf, 0x48, 0x13, 0x8c, 0xd4, 0x4b, 0xc8, 0x7e, 0x01,
+	0x8d, 0x62, 0x01, 0x0b, 0xdf, 0x85, 0xfa, 0xbe, 0xdd, 0x62, 0xe9, 0x99, 0x1f, 0x4c, 0x78, 0xd6,
+	0xce, 0x91, 0xee, 0x16, 0xac, 0x12, 0x59, 0x4f, 0xed, 0xcb, 0x6c, 0xf8, 0x20, 0xd2, 0x06, 0xb3,
+	0x83, 0xdc, 0x43, 0x03, 0xaa, 0x71, 0xb4, 0x13, 0x19, 0x72, 0x70, 0xb5, 0x67, 0x17, 0xee, 0xd7,
+	0xe0, 0x94, 0x95, 0xb0, 0x8b, 0xf7, 0xa1, 0xa6, 0x52, 0x0c, 0x46, 0xb9, 0x89, 0xd5, 0xa2, 0x09,
+	0x5b, 0x74, 0x3f, 0xc5, 0x89, 0x15, 0x86, 0xbb, 0x1f, 0xc2, 0x9a, 0x9d, 0x91, 0x0c, 0xbf, 0x53,
+	0x81, 0x51, 0x43, 0x0b, 0x9d, 0x34, 0x64, 0x15, 0x2e, 0x27, 0xfd, 0x41, 0x8c, 0xc1, 0xb6, 0xed,
+	0xc8, 0x42, 0xaf, 0x9e, 0x74, 0x69, 0xe9, 0xfe, 0x3a, 0x0f, 0xeb, 0x67, 0xd4, 0xb2, 0x2b, 0x09,
+	0x55, 0x83, 0x46, 0xc6, 0x13, 0x53, 0x76, 0x8c, 0xbd, 0xf1, 0x18, 0x7b, 0x3c, 0xc6, 0xde, 0xc7,
+	0x18, 0x25, 0xdd, 0x77, 0xc7, 0xa6, 0x7e, 0xfb, 0x6b, 0x63, 0x33, 0x8c, 0xcc, 0x68, 0x6f, 0xe0,
+	0x05, 0xb8, 0xe3, 0xf3, 0xcc, 0xdb, 0xaf, 0x3b, 0x7a, 0xb8, 0xed, 0x9b, 0x83, 0x54, 0x69, 0x2a,
+	0xd0, 0x3d, 0xcb, 0x2c, 0x62, 0x58, 0xcc, 0x48, 0xb5, 0x9f, 0x22, 0xc6, 0xcd, 0xf9, 0x57, 0x2f,
+	0x04, 0x96, 0xff, 0x21, 0x62, 0x3c, 0xd5, 0xe6, 0x85, 0xff, 0xd7, 0xe6, 0x5b, 0xb0, 0x6c, 0x5b,
+	0x85, 0x18, 0x7f, 0x1a, 0xe3, 0xfe, 0xa4, 0xbf, 0x02, 0x2e, 0x91, 0x71, 0x3b, 0x6d, 0xf4, 0xec,
+	0x7e, 0x09, 0x2b, 0xb3, 0xe0, 0xc9, 0xd5, 0xad, 0x7e, 0x3b, 0xde, 0xe0, 0x86, 0xae, 0xcc, 0xdc,
+	0x5c, 0xc6, 0xe7, 0x93, 0x4e, 0x50, 0xf7, 0x73, 0x66, 0xeb, 0xca, 0x58, 0x26, 0x81, 0xd2, 0xf7,
+	0xcc, 0x85, 0xc3, 0x2e, 0x56, 0xa0, 0x36, 0x52, 0x51, 0x38, 0x32, 0xcd, 0x79, 0x7a, 0xe7, 0xbc,
+	0x72, 0x1f, 0xc3, 0xb5, 0x53, 0x5c, 0x6c, 0xed, 0x23, 0xb8, 0xac, 0x13, 0x99, 0xea, 0x11, 0x1a,
+	0xbe, 0xf9, 0xeb, 0x45, 0x77, 0x5c, 0xf3, 0x88, 0x41, 0x6c, 0x72, 0x52, 0xd4, 0xf9, 0xb7, 0x0e,
+	0x55, 0x22, 0x17, 0xdb, 0x50, 0xb3, 0x21, 0x24, 0xda, 0x45, 0x8a, 0xd3, 0x19, 0xe7, 0xbc, 0x75,
+	0x0e, 0xc2, 0x3a, 0x73, 0xd7, 0xbe, 0xff, 0xfd, 0x9f, 0x9f, 0xe7, 0x57, 0x44, 0xc3, 0x2f, 0xe4,
+	0xab, 0x4d, 0x36, 0x11, 0x42, 0x95, 0x32, 0x49, 0x6c, 0x94, 0x30, 0x4d, 0xc7, 0x9d, 0xd3, 0x3e,
+	0x1b, 0xc0, 0x4a, 0x6f, 0x92, 0xd2, 0xb2, 0x58, 0x2a, 0x2a, 0x51, 0xcc, 0x89, 0x5d, 0xa8, 0x52,
+	0x64, 0x95, 0x0a, 0x4d, 0x67, 0x9f, 0xd3, 0x3e, 0x1b, 0xc0, 0x42, 0x37, 0x48, 0x68, 0x43, 0xac,
+	0x17, 0x85, 0x28, 0xda, 0xfc, 0x43, 0x7e, 0x8b, 0x47, 0x02, 0xa1, 0xce, 0x71, 0x25, 0xca, 0xfa,
+	0x54, 0xcc, 0x3e, 0xc7, 0x3d, 0x0f, 0xc2, 0xc2, 0xeb, 0x24, 0x7c, 0x4d, 0x2c, 0x17, 0x85, 0x39,
+	0xd7, 0xc4, 0x0f, 0x15, 0xb8, 0x5a, 0x08, 0x28, 0xf1, 0x4e, 0x09, 0x69, 0x59, 0xea, 0x39, 0x9b,
+	0x17, 0x03, 0xd9, 0xc3, 0x75, 0xf2, 0xd0, 0x12, 0x6b, 0x45, 0x0f, 0x1c, 0x03, 0x23, 0x16, 0xfe,
+	0xa5, 0x02, 0xaf, 0xcf, 0x06, 0x93, 0xb8, 0x59, 0x36, 0x2d, 0xe5, 0xc9, 0xe7, 0xdc, 0x7a, 0x29,
+	0x2c, 0x7b, 0xea, 0x90, 0xa7, 0xdb, 0xe2, 0xe6, 0xcc, 0x8c, 0xe5, 0xf8, 0xbe, 0x75, 0xa7, 0xfd,
+	0xc3, 0x3c, 0x4d, 0x8f, 0xc4, 0x53, 0xb8, 0x32, 0xb9, 0xe1, 0xe2, 0xed, 0x32, 0xb5, 0x99, 0xb0,
+	0x70, 0xae, 0x9f, 0x0f, 0x62, 0x2f, 0x6d, 0xf2, 0xe2, 0x88, 0xe6, 0x8c, 0x17, 0xc4, 0xb8, 0x4f,
+	0x91, 0x20, 0x7e, 0xac, 0x00, 0xbc, 0xb8, 0xc2, 0xa2, 0x8c, 0xf6, 0x54, 0x5a, 0x38, 0x37, 0x2e,
+	0x40, 0xb1, 0xfa, 0x7b, 0xa4, 0xee, 0x89, 0xdb, 0x45, 0xf5, 0x01, 0x23, 0xfb, 0xd2, 0xbc, 0x18,
+	0x50, 0xff, 0xd0, 0xe6, 0xca, 0x51, 0xb7, 0xf7, 0xec, 0xb8, 0x55, 0x79, 0x7e, 0xdc, 0xaa, 0xfc,
+	0x7d, 0xdc, 0xaa, 0xfc, 0x74, 0xd2, 0x9a, 0x7b, 0x7e, 0xd2, 0x9a, 0xfb, 0xe3, 0xa4, 0x35, 0xf7,
+	0xf8, 0x83, 0xa9, 0xa0, 0xbe, 0x67, 0x19, 0x2d, 0x31, 0x05, 0x75, 0x88, 0xb1, 0x4c, 0xc2, 0x3c,
+	0xc1, 0x9f, 0xb2, 0x18, 0xc5, 0xf7, 0xa0, 0x46, 0xff, 0x8d, 0xee, 0xfe, 0x37, 0x00, 0x29, 0x94,
+	0x07, 0x72, 0xb3, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the vbank module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// State queries current state of the vbank module.
+	State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	// Holds queries the active escrow holds of an account.
+	Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error)
+	// Watches queries the balance watches registered by the VM.
+	Watches(ctx context.Context, in *QueryWatchesRequest, opts ...grpc.CallOption) (*QueryWatchesResponse, error)
+	// RewardHistory queries the most recent reward epochs.
+	RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error)
+	// ProjectedRewards simulates the distribution of the current reward pool
+	// over the coming blocks with the current params.
+	ProjectedRewards(ctx context.Context, in *QueryProjectedRewardsRequest, opts ...grpc.CallOption) (*QueryProjectedRewardsResponse, error)
+	// PoolFlows queries the cumulative flows into and out of the accounted
+	// pools, by category.
+	PoolFlows(ctx context.Context, in *QueryPoolFlowsRequest, opts ...grpc.CallOption) (*QueryPoolFlowsResponse, error)
+	// BalancesAt queries the balances of an address registered for snapshots,
+	// as of the last snapshot at or before a height.
+	BalancesAt(ctx context.Context, in *QueryBalancesAtRequest, opts ...grpc.CallOption) (*QueryBalancesAtResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) State(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error) {
+	out := new(QueryStateResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/State", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error) {
+	out := new(QueryHoldsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/Holds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Watches(ctx context.Context, in *QueryWatchesRequest, opts ...grpc.CallOption) (*QueryWatchesResponse, error) {
+	out := new(QueryWatchesResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/Watches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error) {
+	out := new(QueryRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/RewardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedRewards(ctx context.Context, in *QueryProjectedRewardsRequest, opts ...grpc.CallOption) (*QueryProjectedRewardsResponse, error) {
+	out := new(QueryProjectedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/ProjectedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolFlows(ctx context.Context, in *QueryPoolFlowsRequest, opts ...grpc.CallOption) (*QueryPoolFlowsResponse, error) {
+	out := new(QueryPoolFlowsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/PoolFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalancesAt(ctx context.Context, in *QueryBalancesAtRequest, opts ...grpc.CallOption) (*QueryBalancesAtResponse, error) {
+	out := new(QueryBalancesAtResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vbank.Query/BalancesAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the vbank module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// State queries current state of the vbank module.
+	State(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	// Holds queries the active escrow holds of an account.
+	Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error)
+	// Watches queries the balance watches registered by the VM.
+	Watches(context.Context, *QueryWatchesRequest) (*QueryWatchesResponse, error)
+	// RewardHistory queries the most recent reward epochs.
+	RewardHistory(context.Context, *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error)
+	// ProjectedRewards simulates the distribution of the current reward pool
+	// over the coming blocks with the current params.
+	ProjectedRewards(context.Context, *QueryProjectedRewardsRequest) (*QueryProjectedRewardsResponse, error)
+	// PoolFlows queries the cumulative flows into and out of the accounted
+	// pools, by category.
+	PoolFlows(context.Context, *QueryPoolFlowsRequest) (*QueryPoolFlowsResponse, error)
+	// BalancesAt queries the balances of an address registered for snapshots,
+	// as of the last snapshot at or before a height.
+	BalancesAt(context.Context, *QueryBalancesAtRequest) (*QueryBalancesAtResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) State(ctx context.Context, req *QueryStateRequest) (*QueryStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (*UnimplementedQueryServer) Holds(ctx context.Context, req *QueryHoldsRequest) (*QueryHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holds not implemented")
+}
+func (*UnimplementedQueryServer) Watches(ctx context.Context, req *QueryWatchesRequest) (*QueryWatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Watches not implemented")
+}
+func (*UnimplementedQueryServer) RewardHistory(ctx context.Context, req *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardHistory not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedRewards(ctx context.Context, req *QueryProjectedRewardsRequest) (*QueryProjectedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedRewards not implemented")
+}
+func (*UnimplementedQueryServer) PoolFlows(ctx context.Context, req *QueryPoolFlowsRequest) (*QueryPoolFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolFlows not implemented")
+}
+func (*UnimplementedQueryServer) BalancesAt(ctx context.Context, req *QueryBalancesAtRequest) (*QueryBalancesAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalancesAt not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).State(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/State",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).State(ctx, req.(*QueryStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Holds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/Holds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holds(ctx, req.(*QueryHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Watches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Watches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/Watches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Watches(ctx, req.(*QueryWatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/RewardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardHistory(ctx, req.(*QueryRewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/ProjectedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRewards(ctx, req.(*QueryProjectedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/PoolFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolFlows(ctx, req.(*QueryPoolFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalancesAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalancesAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vbank.Query/BalancesAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalancesAt(ctx, req.(*QueryBalancesAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.vbank.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _Query_State_Handler,
+		},
+		{
+			MethodName: "Holds",
+			Handler:    _Query_Holds_Handler,
+		},
+		{
+			MethodName: "Watches",
+			Handler:    _Query_Watches_Handler,
+		},
+		{
+			MethodName: "RewardHistory",
+			Handler:    _Query_RewardHistory_Handler,
+		},
+		{
+			MethodName: "ProjectedRewards",
+			Handler:    _Query_ProjectedRewards_Handler,
+		},
+		{
+			MethodName: "PoolFlows",
+			Handler:    _Query_PoolFlows_Handler,
+		},
+		{
+			MethodName: "BalancesAt",
+			Handler:    _Query_BalancesAt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/vbank/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Watches) > 0 {
+		for iNdEx := len(m.Watches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Watches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalancesAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalancesAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHoldsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWatchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWatchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Watches) > 0 {
+		for _, e := range m.Watches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryRewardHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProjectedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.NBlocks))
+	}
+	return n
+}
+
+func (m *QueryProjectedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolFlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolFlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBalancesAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBalancesAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWatchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWatchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Watches = append(m.Watches, Watch{})
+			if err := m.Watches[len(m.Watches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, RewardEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NBlocks", wireType)
+			}
+			m.NBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectedRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, RewardEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.State.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolFlowsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -543,12 +2990,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolFlowsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -570,7 +3049,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolFlowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -593,15 +3072,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolFlowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -628,7 +3107,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Flows = append(m.Flows, PoolFlow{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -653,7 +3133,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBalancesAtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -676,12 +3156,63 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalancesAtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalancesAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -703,7 +3234,7 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBalancesAtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -726,15 +3257,15 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalancesAtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalancesAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -761,7 +3292,7 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless validation of a BalanceSnapshot.
+func (s BalanceSnapshot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return fmt.Errorf("cannot convert %s to address: %s", s.Address, err)
+	}
+	if s.Height <= 0 {
+		return fmt.Errorf("snapshot of %s has nonpositive height %d", s.Address, s.Height)
+	}
+	if err := s.Balances.Validate(); err != nil {
+		return fmt.Errorf("snapshot of %s at %d has invalid balances: %s", s.Address, s.Height, err)
+	}
+	return nil
+}
@@ -42,8 +42,34 @@ type Params struct {
 	RewardSmoothingBlocks int64 `protobuf:"varint,3,opt,name=reward_smoothing_blocks,json=rewardSmoothingBlocks,proto3" json:"reward_smoothing_blocks,omitempty" yaml:"reward_smoothing_blocks"`
 	// allowed_monitoring_accounts is an array of account addresses that can be
 	// monitored for sends and receives.  An element of `"*"` will permit any
-	// address.
+	// address.  Deprecated: the VM registers watches instead, and `"*"` is
+	// removed when it registers the first one.
 	AllowedMonitoringAccounts []string `protobuf:"bytes,4,rep,name=allowed_monitoring_accounts,json=allowedMonitoringAccounts,proto3" json:"allowed_monitoring_accounts,omitempty" yaml:"allowed_monitoring_accounts"`
+	// reward_history_epochs is the number of the most recent reward epochs to
+	// keep in the reward history.  A value of zero disables the history.
+	RewardHistoryEpochs int64 `protobuf:"varint,5,opt,name=reward_history_epochs,json=rewardHistoryEpochs,proto3" json:"reward_history_epochs,omitempty" yaml:"reward_history_epochs"`
+	// reward_splits divide the rewards sent every block between destinations
+	// by weight.  The weights must sum to one.  If empty, all rewards are sent
+	// to the fee collector.
+	RewardSplits []RewardSplit `protobuf:"bytes,6,rep,name=reward_splits,json=rewardSplits,proto3" json:"reward_splits" yaml:"reward_splits"`
+	// outflow_limits cap the net amount of each listed denom that the VM may
+	// send out of the vbank module account within a window of blocks.
+	OutflowLimits []OutflowLimit `protobuf:"bytes,7,rep,name=outflow_limits,json=outflowLimits,proto3" json:"outflow_limits" yaml:"outflow_limits"`
+	// snapshot_interval_blocks is the number of blocks between the balance
+	// snapshots of the addresses registered by the VM, taken after the VM has
+	// finished each block whose height is a multiple of it.  A value of zero
+	// disables them.
+	SnapshotIntervalBlocks int64 `protobuf:"varint,8,opt,name=snapshot_interval_blocks,json=snapshotIntervalBlocks,proto3" json:"snapshot_interval_blocks,omitempty" yaml:"snapshot_interval_blocks"`
+	// max_snapshot_addresses is the number of addresses the VM may register
+	// for balance snapshots.  Lowering it does not unregister any address, but
+	// no more are accepted until the count falls below it.  A value of zero
+	// removes the limit.
+	MaxSnapshotAddresses int64 `protobuf:"varint,9,opt,name=max_snapshot_addresses,json=maxSnapshotAddresses,proto3" json:"max_snapshot_addresses,omitempty" yaml:"max_snapshot_addresses"`
+	// snapshot_retention_blocks is the number of blocks for which the balances
+	// of a registered address can be queried.  Older snapshots are pruned,
+	// except for the one giving the balances at the start of the retained
+	// range.  A value of zero keeps every snapshot.
+	SnapshotRetentionBlocks int64 `protobuf:"varint,10,opt,name=snapshot_retention_blocks,json=snapshotRetentionBlocks,proto3" json:"snapshot_retention_blocks,omitempty" yaml:"snapshot_retention_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -99,6 +125,214 @@ func (m *Params) GetAllowedMonitoringAccounts() []string {
 	return nil
 }
 
+func (m *Params) GetRewardHistoryEpochs() int64 {
+	if m != nil {
+		return m.RewardHistoryEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetRewardSplits() []RewardSplit {
+	if m != nil {
+		return m.RewardSplits
+	}
+	return nil
+}
+
+func (m *Params) GetOutflowLimits() []OutflowLimit {
+	if m != nil {
+		return m.OutflowLimits
+	}
+	return nil
+}
+
+func (m *Params) GetSnapshotIntervalBlocks() int64 {
+	if m != nil {
+		return m.SnapshotIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSnapshotAddresses() int64 {
+	if m != nil {
+		return m.MaxSnapshotAddresses
+	}
+	return 0
+}
+
+func (m *Params) GetSnapshotRetentionBlocks() int64 {
+	if m != nil {
+		return m.SnapshotRetentionBlocks
+	}
+	return 0
+}
+
+// RewardSplit directs a share of the rewards to a destination.
+type RewardSplit struct {
+	// destination is one of the module accounts "fee_collector", "vbank/reserve"
+	// or "vbank/giveaway", or "community_pool" for the community pool of the
+	// distribution module.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// weight is the fraction of the rewards sent to the destination.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *RewardSplit) Reset()         { *m = RewardSplit{} }
+func (m *RewardSplit) String() string { return proto.CompactTextString(m) }
+func (*RewardSplit) ProtoMessage()    {}
+func (*RewardSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{1}
+}
+func (m *RewardSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardSplit.Merge(m, src)
+}
+func (m *RewardSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardSplit proto.InternalMessageInfo
+
+func (m *RewardSplit) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+// OutflowLimit caps the net outflow of a denom from the vbank module account.
+type OutflowLimit struct {
+	// denom is the limited denomination.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the most that may be sent out (net of what is sent in) within
+	// a window.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// window_blocks is the length of a window in blocks.
+	WindowBlocks int64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+}
+
+func (m *OutflowLimit) Reset()         { *m = OutflowLimit{} }
+func (m *OutflowLimit) String() string { return proto.CompactTextString(m) }
+func (*OutflowLimit) ProtoMessage()    {}
+func (*OutflowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{2}
+}
+func (m *OutflowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutflowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowLimit.Merge(m, src)
+}
+func (m *OutflowLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowLimit proto.InternalMessageInfo
+
+func (m *OutflowLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OutflowLimit) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+// OutflowWindow is the net outflow of a limited denom in its current window.
+type OutflowWindow struct {
+	// denom is the limited denomination.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_block is the block at which the window started.
+	StartBlock int64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty" yaml:"start_block"`
+	// net_outflow is the amount sent out of the module account less the amount
+	// sent in since the window started.  It may be negative.
+	NetOutflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=net_outflow,json=netOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"net_outflow"`
+}
+
+func (m *OutflowWindow) Reset()         { *m = OutflowWindow{} }
+func (m *OutflowWindow) String() string { return proto.CompactTextString(m) }
+func (*OutflowWindow) ProtoMessage()    {}
+func (*OutflowWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{3}
+}
+func (m *OutflowWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutflowWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowWindow.Merge(m, src)
+}
+func (m *OutflowWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowWindow proto.InternalMessageInfo
+
+func (m *OutflowWindow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OutflowWindow) GetStartBlock() int64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
 // The current state of the module.
 type State struct {
 	// rewardPool is the current balance of rewards in the module account.
@@ -117,7 +351,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e89b3b9e5e671b4, []int{1}
+	return fileDescriptor_5e89b3b9e5e671b4, []int{4}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,317 +408,3037 @@ func (m *State) GetLastRewardDistributionBlock() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "agoric.vbank.Params")
-	proto.RegisterType((*State)(nil), "agoric.vbank.State")
+// Hold is an escrow of an account's coins in the vbank/hold module account,
+// pending the outcome of a VM operation.
+type Hold struct {
+	// id is the VM-assigned identifier of the hold.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account whose coins are held, and to which
+	// they are returned if the hold is released.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// coins are the held coins.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// expiry_time, if nonzero, is the block time (in seconds since the Unix
+	// epoch) at or after which the hold is automatically released.
+	ExpiryTime int64 `protobuf:"varint,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty" yaml:"expiry_time"`
+	// release_attempts is the number of times that automatically releasing the
+	// hold has failed.  Each failure postpones expiry_time by a growing delay,
+	// until the hold is no longer released automatically.
+	ReleaseAttempts uint32 `protobuf:"varint,5,opt,name=release_attempts,json=releaseAttempts,proto3" json:"release_attempts,omitempty" yaml:"release_attempts"`
 }
 
-func init() { proto.RegisterFile("agoric/vbank/vbank.proto", fileDescriptor_5e89b3b9e5e671b4) }
-
-var fileDescriptor_5e89b3b9e5e671b4 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x26, 0x54, 0xf4, 0x5a, 0x06, 0x4c, 0x01, 0xa7, 0x45, 0x76, 0x74, 0x08, 0x08,
-	0x03, 0xb6, 0x0a, 0x0b, 0xaa, 0xc4, 0x50, 0x53, 0x3a, 0x15, 0x54, 0xb9, 0x5b, 0x97, 0xe8, 0x7c,
-	0xbe, 0x3a, 0x56, 0x6c, 0xbf, 0x70, 0x77, 0x69, 0xe9, 0xca, 0xc2, 0xca, 0x08, 0x5b, 0x67, 0x3e,
-	0x49, 0xc5, 0xd4, 0x11, 0x21, 0x61, 0x50, 0xbb, 0x30, 0xfb, 0x13, 0x20, 0xdf, 0x5d, 0x69, 0x83,
-	0x50, 0x11, 0x4b, 0x92, 0xcb, 0xef, 0xff, 0x9e, 0xff, 0xef, 0xdd, 0xf3, 0x43, 0x0e, 0x49, 0x81,
-	0x67, 0x34, 0xd8, 0x8b, 0x49, 0x39, 0xd2, 0x9f, 0xfe, 0x98, 0x83, 0x04, 0x7b, 0x41, 0x13, 0x5f,
-	0xfd, 0xb7, 0xb4, 0x98, 0x42, 0x0a, 0x0a, 0x04, 0xcd, 0x2f, 0xad, 0x59, 0x72, 0x29, 0x88, 0x02,
-	0x44, 0x10, 0x13, 0xc1, 0x82, 0xbd, 0x95, 0x98, 0x49, 0xb2, 0x12, 0x50, 0xc8, 0x4a, 0xcd, 0xf1,
-	0xe7, 0x36, 0x9a, 0xdd, 0x22, 0x9c, 0x14, 0xc2, 0x1e, 0xa2, 0x3b, 0x9c, 0xed, 0x13, 0x9e, 0x0c,
-	0xd8, 0x18, 0xe8, 0x70, 0x90, 0x4c, 0x38, 0x91, 0x19, 0x94, 0x83, 0x38, 0x07, 0x3a, 0x12, 0x8e,
-	0xd5, 0xb3, 0xfa, 0xed, 0xf0, 0x41, 0x5d, 0x79, 0x77, 0x0f, 0x48, 0x91, 0xaf, 0xe2, 0xcb, 0xd4,
-	0x38, 0xea, 0x6a, 0xfc, 0xa2, 0xa1, 0xeb, 0x06, 0x86, 0x8a, 0xd9, 0xef, 0x2c, 0xd4, 0x1d, 0x33,
-	0x6e, 0x22, 0x4d, 0x9a, 0x5d, 0x4e, 0x68, 0xa3, 0x71, 0x66, 0x7a, 0x56, 0x7f, 0x2e, 0xdc, 0x3c,
-	0xaa, 0xbc, 0xd6, 0xd7, 0xca, 0x5b, 0xd6, 0x05, 0x88, 0x64, 0xe4, 0x67, 0x10, 0x14, 0x44, 0x0e,
-	0xfd, 0x4d, 0x96, 0x12, 0x7a, 0xb0, 0xce, 0x68, 0x5d, 0x79, 0xf7, 0xb4, 0x95, 0x24, 0x13, 0x94,
-	0x33, 0xc9, 0xfe, 0x9e, 0x12, 0x47, 0xb7, 0xc6, 0x8c, 0x2b, 0x27, 0x91, 0x22, 0x1b, 0x06, 0xd8,
-	0x3b, 0xe8, 0xb6, 0xd1, 0x8a, 0x02, 0x40, 0x0e, 0xb3, 0x32, 0x3d, 0x2b, 0xb7, 0xad, 0xca, 0xc5,
-	0x75, 0xe5, 0xb9, 0x53, 0xe5, 0xfe, 0x29, 0xc4, 0xd1, 0x4d, 0x4d, 0xb6, 0xcf, 0x80, 0xa9, 0x72,
-	0x17, 0x2d, 0x93, 0x3c, 0x87, 0x7d, 0x96, 0x0c, 0x0a, 0x28, 0x33, 0x09, 0xbc, 0x09, 0x22, 0x94,
-	0xc2, 0xa4, 0x94, 0xc2, 0xe9, 0xf4, 0xda, 0xfd, 0xb9, 0xf0, 0x7e, 0x5d, 0x79, 0x58, 0xe7, 0xbf,
-	0x44, 0x8c, 0xa3, 0xae, 0xa1, 0x2f, 0x7f, 0xc3, 0x35, 0xc3, 0x56, 0xaf, 0x7e, 0x38, 0xf4, 0x5a,
-	0x3f, 0x0f, 0x3d, 0x0b, 0x7f, 0x6b, 0xa3, 0x2b, 0xdb, 0x92, 0x48, 0x66, 0xbf, 0xb5, 0xd0, 0xbc,
-	0xf1, 0x3b, 0x06, 0xc8, 0x1d, 0xab, 0xd7, 0xee, 0xcf, 0x3f, 0xee, 0xfa, 0xba, 0x99, 0x7e, 0x33,
-	0x0d, 0xbe, 0x99, 0x06, 0xff, 0x39, 0x64, 0x65, 0xb8, 0xd1, 0xb4, 0xbb, 0xae, 0x3c, 0x7b, 0xaa,
-	0xd6, 0x26, 0x16, 0x7f, 0xfa, 0xee, 0xf5, 0xd3, 0x4c, 0x0e, 0x27, 0xb1, 0x4f, 0xa1, 0x08, 0xcc,
-	0x40, 0xe9, 0xaf, 0x47, 0x22, 0x19, 0x05, 0xf2, 0x60, 0xcc, 0x84, 0x4a, 0x23, 0x22, 0xa4, 0x23,
-	0xb7, 0x00, 0x72, 0xfb, 0xa3, 0x85, 0x6e, 0x98, 0x44, 0xaa, 0x55, 0x03, 0x52, 0x34, 0x8e, 0x9d,
-	0x99, 0x7f, 0x99, 0x79, 0x65, 0xcc, 0x2c, 0x4d, 0x99, 0xb9, 0x98, 0xe3, 0xff, 0x4c, 0x5d, 0xd7,
-	0x19, 0xd4, 0xbd, 0xac, 0xa9, 0x78, 0xfb, 0x19, 0xba, 0x96, 0x13, 0x21, 0x07, 0x82, 0xbd, 0x9e,
-	0xb0, 0x92, 0x32, 0x75, 0xdd, 0x9d, 0xd0, 0xa9, 0x2b, 0x6f, 0x51, 0x3f, 0x75, 0x0a, 0xe3, 0x68,
-	0xa1, 0x39, 0x6f, 0x9b, 0xa3, 0x5d, 0x22, 0x57, 0x71, 0x63, 0x2d, 0xc9, 0x84, 0xe4, 0x59, 0x3c,
-	0x39, 0x7f, 0x01, 0x9c, 0x8e, 0x1a, 0x9f, 0x87, 0xe7, 0x23, 0x7a, 0xb9, 0x1e, 0x47, 0xcb, 0x8d,
-	0x40, 0x8f, 0xe7, 0xfa, 0x05, 0xac, 0x4c, 0xaf, 0x76, 0x9a, 0xfb, 0x0d, 0xa3, 0xa3, 0x13, 0xd7,
-	0x3a, 0x3e, 0x71, 0xad, 0x1f, 0x27, 0xae, 0xf5, 0xfe, 0xd4, 0x6d, 0x1d, 0x9f, 0xba, 0xad, 0x2f,
-	0xa7, 0x6e, 0x6b, 0xe7, 0xe9, 0x85, 0x5e, 0xac, 0xe9, 0x7d, 0xa1, 0x97, 0x83, 0xea, 0x45, 0x0a,
-	0x39, 0x29, 0xd3, 0xb3, 0x26, 0xbd, 0x31, 0xab, 0x44, 0x75, 0x28, 0x9e, 0x55, 0x7b, 0xe0, 0xc9,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe4, 0xc6, 0x3a, 0x67, 0x04, 0x00, 0x00,
+func (m *Hold) Reset()         { *m = Hold{} }
+func (m *Hold) String() string { return proto.CompactTextString(m) }
+func (*Hold) ProtoMessage()    {}
+func (*Hold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{5}
 }
-
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.RewardEpochDurationBlocks != that1.RewardEpochDurationBlocks {
-		return false
-	}
-	if !this.PerEpochRewardFraction.Equal(that1.PerEpochRewardFraction) {
-		return false
-	}
-	if this.RewardSmoothingBlocks != that1.RewardSmoothingBlocks {
-		return false
-	}
-	if len(this.AllowedMonitoringAccounts) != len(that1.AllowedMonitoringAccounts) {
-		return false
-	}
-	for i := range this.AllowedMonitoringAccounts {
-		if this.AllowedMonitoringAccounts[i] != that1.AllowedMonitoringAccounts[i] {
-			return false
+func (m *Hold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *State) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Hold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hold.Merge(m, src)
+}
+func (m *Hold) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hold) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hold.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*State)
-	if !ok {
-		that2, ok := that.(State)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_Hold proto.InternalMessageInfo
+
+func (m *Hold) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *Hold) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(this.RewardPool) != len(that1.RewardPool) {
-		return false
+	return ""
+}
+
+func (m *Hold) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	for i := range this.RewardPool {
-		if !this.RewardPool[i].Equal(&that1.RewardPool[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *Hold) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	if len(this.RewardBlockAmount) != len(that1.RewardBlockAmount) {
-		return false
+	return 0
+}
+
+func (m *Hold) GetReleaseAttempts() uint32 {
+	if m != nil {
+		return m.ReleaseAttempts
 	}
-	for i := range this.RewardBlockAmount {
-		if !this.RewardBlockAmount[i].Equal(&that1.RewardBlockAmount[i]) {
-			return false
+	return 0
+}
+
+// Watch is a registration by the VM for VBANK_BALANCE_UPDATE entries about an
+// address.
+type Watch struct {
+	// address is the watched account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the watched denomination, or "*" for every denomination.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// count is the number of outstanding registrations of the watch.
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *Watch) Reset()         { *m = Watch{} }
+func (m *Watch) String() string { return proto.CompactTextString(m) }
+func (*Watch) ProtoMessage()    {}
+func (*Watch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{6}
+}
+func (m *Watch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Watch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Watch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.LastSequence != that1.LastSequence {
-		return false
-	}
-	if this.LastRewardDistributionBlock != that1.LastRewardDistributionBlock {
-		return false
-	}
-	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Watch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Watch.Merge(m, src)
+}
+func (m *Watch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Watch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Watch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Watch proto.InternalMessageInfo
+
+func (m *Watch) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Watch) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedMonitoringAccounts) > 0 {
-		for iNdEx := len(m.AllowedMonitoringAccounts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedMonitoringAccounts[iNdEx])
-			copy(dAtA[i:], m.AllowedMonitoringAccounts[iNdEx])
-			i = encodeVarintVbank(dAtA, i, uint64(len(m.AllowedMonitoringAccounts[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *Watch) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// BalanceSnapshot is the balances of a registered address at the end of a
+// snapshot block.  A snapshot is only recorded when the balances differ from
+// those of the previous one, so it stands until the next.
+type BalanceSnapshot struct {
+	// address is the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the block at whose end the snapshot was taken.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// balances are the account's balances.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+}
+
+func (m *BalanceSnapshot) Reset()         { *m = BalanceSnapshot{} }
+func (m *BalanceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*BalanceSnapshot) ProtoMessage()    {}
+func (*BalanceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{7}
+}
+func (m *BalanceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.RewardSmoothingBlocks != 0 {
-		i = encodeVarintVbank(dAtA, i, uint64(m.RewardSmoothingBlocks))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *BalanceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceSnapshot.Merge(m, src)
+}
+func (m *BalanceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceSnapshot proto.InternalMessageInfo
+
+func (m *BalanceSnapshot) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	{
-		size := m.PerEpochRewardFraction.Size()
-		i -= size
-		if _, err := m.PerEpochRewardFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+func (m *BalanceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BalanceSnapshot) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// RewardEpoch records the distribution of rewards over a single epoch.
+type RewardEpoch struct {
+	// start_block is the block at which the epoch started.
+	StartBlock int64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty" yaml:"start_block"`
+	// reward_pool is the balance of the reward pool when the epoch started.
+	RewardPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reward_pool,json=rewardPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool" yaml:"reward_pool"`
+	// per_epoch_reward_fraction is the fraction of the reward pool allotted to
+	// the epoch.
+	PerEpochRewardFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=per_epoch_reward_fraction,json=perEpochRewardFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"per_epoch_reward_fraction" yaml:"per_epoch_reward_fraction"`
+	// epoch_amount is the amount allotted to the epoch.
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// distributed is the amount actually sent to the reward distributor during
+	// the epoch so far.
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+	// last_distribution_block is the last block in which rewards of the epoch
+	// were sent, or zero if none have been.
+	LastDistributionBlock int64 `protobuf:"varint,6,opt,name=last_distribution_block,json=lastDistributionBlock,proto3" json:"last_distribution_block,omitempty" yaml:"last_distribution_block"`
+}
+
+func (m *RewardEpoch) Reset()         { *m = RewardEpoch{} }
+func (m *RewardEpoch) String() string { return proto.CompactTextString(m) }
+func (*RewardEpoch) ProtoMessage()    {}
+func (*RewardEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{8}
+}
+func (m *RewardEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintVbank(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.RewardEpochDurationBlocks != 0 {
-		i = encodeVarintVbank(dAtA, i, uint64(m.RewardEpochDurationBlocks))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *RewardEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardEpoch.Merge(m, src)
+}
+func (m *RewardEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardEpoch proto.InternalMessageInfo
+
+func (m *RewardEpoch) GetStartBlock() int64 {
+	if m != nil {
+		return m.StartBlock
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *State) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardEpoch) GetRewardPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPool
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *State) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RewardEpoch) GetEpochAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochAmount
+	}
+	return nil
 }
 
-func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastRewardDistributionBlock != 0 {
-		i = encodeVarintVbank(dAtA, i, uint64(m.LastRewardDistributionBlock))
-		i--
-		dAtA[i] = 0x20
+func (m *RewardEpoch) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
 	}
-	if m.LastSequence != 0 {
-		i = encodeVarintVbank(dAtA, i, uint64(m.LastSequence))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *RewardEpoch) GetLastDistributionBlock() int64 {
+	if m != nil {
+		return m.LastDistributionBlock
 	}
-	if len(m.RewardBlockAmount) > 0 {
-		for iNdEx := len(m.RewardBlockAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardBlockAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVbank(dAtA, i, uint64(size))
+	return 0
+}
+
+// PoolFlow is the total amount that has flowed into or out of a vbank pool for
+// one category of cause.
+type PoolFlow struct {
+	// pool is the module account name of the pool, such as "vbank/reserve".
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// direction is "in" for coins sent to the pool, or "out" for coins sent
+	// from it.
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	// category is the cause of the flow, such as "admission_fee".
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// amount is the cumulative amount of the flow.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *PoolFlow) Reset()         { *m = PoolFlow{} }
+func (m *PoolFlow) String() string { return proto.CompactTextString(m) }
+func (*PoolFlow) ProtoMessage()    {}
+func (*PoolFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e89b3b9e5e671b4, []int{9}
+}
+func (m *PoolFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFlow.Merge(m, src)
+}
+func (m *PoolFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolFlow proto.InternalMessageInfo
+
+func (m *PoolFlow) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *PoolFlow) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *PoolFlow) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *PoolFlow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "agoric.vbank.Params")
+	proto.RegisterType((*RewardSplit)(nil), "agoric.vbank.RewardSplit")
+	proto.RegisterType((*OutflowLimit)(nil), "agoric.vbank.OutflowLimit")
+	proto.RegisterType((*OutflowWindow)(nil), "agoric.vbank.OutflowWindow")
+	proto.RegisterType((*State)(nil), "agoric.vbank.State")
+	proto.RegisterType((*Hold)(nil), "agoric.vbank.Hold")
+	proto.RegisterType((*Watch)(nil), "agoric.vbank.Watch")
+	proto.RegisterType((*BalanceSnapshot)(nil), "agoric.vbank.BalanceSnapshot")
+	proto.RegisterType((*RewardEpoch)(nil), "agoric.vbank.RewardEpoch")
+	proto.RegisterType((*PoolFlow)(nil), "agoric.vbank.PoolFlow")
+}
+
+func init() { proto.RegisterFile("agoric/vbank/vbank.proto", fileDescriptor_5e89b3b9e5e671b4) }
+
+var fileDescriptor_5e89b3b9e5e671b4 = []byte{
+	// 1305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0xc6, 0x1f, 0x4d, 0xc6, 0x49, 0xfb, 0xbe, 0xd3, 0x24, 0xdd, 0x7c, 0xd4, 0x6b, 0xb6,
+	0x7c, 0x84, 0x03, 0x36, 0x05, 0x21, 0x50, 0x11, 0x48, 0x31, 0x25, 0x6d, 0xa5, 0x02, 0xd5, 0xa4,
+	0x52, 0xa5, 0x0a, 0xb4, 0x1d, 0xef, 0x4e, 0xed, 0x55, 0x76, 0x77, 0xcc, 0xce, 0xa4, 0x4e, 0xae,
+	0x95, 0x10, 0x57, 0x4e, 0x08, 0x6e, 0x3d, 0x70, 0x81, 0x23, 0xff, 0x02, 0x97, 0x1e, 0x7b, 0x44,
+	0x48, 0x2c, 0xa8, 0xbd, 0x70, 0xf6, 0x91, 0x13, 0x9a, 0x99, 0x67, 0xed, 0xb5, 0x69, 0xbe, 0x24,
+	0x10, 0x97, 0xc4, 0x33, 0xbf, 0xe7, 0xf9, 0xcd, 0xf3, 0x3d, 0xb3, 0xc8, 0xa6, 0x5d, 0x9e, 0x86,
+	0x7e, 0xeb, 0x41, 0x87, 0x26, 0xbb, 0xe6, 0x6f, 0xb3, 0x9f, 0x72, 0xc9, 0xf1, 0x82, 0x41, 0x9a,
+	0x7a, 0x6f, 0x6d, 0xa9, 0xcb, 0xbb, 0x5c, 0x03, 0x2d, 0xf5, 0xcb, 0xc8, 0xac, 0xd5, 0x7d, 0x2e,
+	0x62, 0x2e, 0x5a, 0x1d, 0x2a, 0x58, 0xeb, 0xc1, 0xe5, 0x0e, 0x93, 0xf4, 0x72, 0xcb, 0xe7, 0x61,
+	0x62, 0x70, 0xf7, 0xcf, 0x33, 0xa8, 0x7a, 0x8b, 0xa6, 0x34, 0x16, 0xb8, 0x87, 0x36, 0x52, 0x36,
+	0xa0, 0x69, 0xe0, 0xb1, 0x3e, 0xf7, 0x7b, 0x5e, 0xb0, 0x97, 0x52, 0x19, 0xf2, 0xc4, 0xeb, 0x44,
+	0xdc, 0xdf, 0x15, 0xb6, 0xd5, 0xb0, 0x36, 0x4b, 0xed, 0x57, 0x86, 0x99, 0x73, 0xe9, 0x80, 0xc6,
+	0xd1, 0x15, 0xf7, 0x28, 0x69, 0x97, 0xac, 0x1a, 0xf8, 0x43, 0x85, 0x5e, 0x05, 0xb0, 0xad, 0x31,
+	0xfc, 0xa5, 0x85, 0x56, 0xfb, 0x2c, 0x05, 0x4d, 0xa0, 0xb9, 0x9f, 0x52, 0x5f, 0xc9, 0xd8, 0xb3,
+	0x0d, 0x6b, 0x73, 0xbe, 0x7d, 0xf3, 0x71, 0xe6, 0xcc, 0xfc, 0x92, 0x39, 0xeb, 0xc6, 0x01, 0x11,
+	0xec, 0x36, 0x43, 0xde, 0x8a, 0xa9, 0xec, 0x35, 0x6f, 0xb2, 0x2e, 0xf5, 0x0f, 0xae, 0x32, 0x7f,
+	0x98, 0x39, 0x2f, 0x19, 0x53, 0x82, 0x50, 0xf8, 0x29, 0x93, 0xec, 0xf9, 0x94, 0x2e, 0x59, 0xe9,
+	0xb3, 0x54, 0x5b, 0x42, 0x34, 0xb2, 0x0d, 0x00, 0xbe, 0x8b, 0x2e, 0x80, 0xac, 0x88, 0x39, 0x97,
+	0xbd, 0x30, 0xe9, 0xe6, 0xee, 0x96, 0xb4, 0xbb, 0xee, 0x30, 0x73, 0xea, 0x13, 0xee, 0x4e, 0x0b,
+	0xba, 0x64, 0xd9, 0x20, 0x3b, 0x39, 0x00, 0x5e, 0xde, 0x47, 0xeb, 0x34, 0x8a, 0xf8, 0x80, 0x05,
+	0x5e, 0xcc, 0x93, 0x50, 0xf2, 0x54, 0x29, 0x51, 0xdf, 0xe7, 0x7b, 0x89, 0x14, 0x76, 0xb9, 0x51,
+	0xda, 0x9c, 0x6f, 0xbf, 0x3c, 0xcc, 0x1c, 0xd7, 0xf0, 0x1f, 0x21, 0xec, 0x92, 0x55, 0x40, 0x3f,
+	0x1a, 0x81, 0x5b, 0x80, 0xe1, 0xdb, 0x08, 0x0c, 0xf0, 0x7a, 0xa1, 0x90, 0x3c, 0x3d, 0x30, 0x41,
+	0x10, 0x76, 0x45, 0x7b, 0xd0, 0x18, 0x66, 0xce, 0xc6, 0x84, 0x07, 0x93, 0x62, 0x2e, 0x39, 0x6f,
+	0xf6, 0xaf, 0x9b, 0x6d, 0x1d, 0x26, 0x81, 0x3f, 0x45, 0x8b, 0xb9, 0xc3, 0xfd, 0x28, 0x94, 0xc2,
+	0xae, 0x36, 0x4a, 0x9b, 0xb5, 0x37, 0x56, 0x9b, 0xc5, 0xa2, 0x6b, 0x9a, 0x70, 0xee, 0x28, 0x89,
+	0xf6, 0x86, 0xca, 0xd8, 0x30, 0x73, 0x96, 0x26, 0xc3, 0xa5, 0xb5, 0x5d, 0xb2, 0x90, 0x8e, 0x45,
+	0x05, 0xbe, 0x87, 0xce, 0xf2, 0x3d, 0x79, 0x3f, 0xe2, 0x03, 0x2f, 0x0a, 0x63, 0x45, 0x7f, 0x46,
+	0xd3, 0xaf, 0x4d, 0xd2, 0x7f, 0x62, 0x64, 0x6e, 0x2a, 0x91, 0xf6, 0x45, 0xe0, 0x5f, 0x36, 0xfc,
+	0x93, 0xfa, 0x2e, 0x59, 0xe4, 0x05, 0x61, 0x81, 0x3f, 0x43, 0xb6, 0x48, 0x68, 0x5f, 0xf4, 0xb8,
+	0xf4, 0xc2, 0x44, 0xb2, 0xf4, 0x01, 0x8d, 0xf2, 0xd4, 0xce, 0xe9, 0xc0, 0x5c, 0x1a, 0x66, 0x8e,
+	0x63, 0xb8, 0x0e, 0x93, 0x74, 0xc9, 0x4a, 0x0e, 0xdd, 0x00, 0x04, 0x92, 0x7b, 0x07, 0xad, 0xc4,
+	0x74, 0xdf, 0x1b, 0x29, 0xd2, 0x20, 0x48, 0x99, 0x10, 0x4c, 0xd8, 0xf3, 0x9a, 0xfc, 0x85, 0x61,
+	0xe6, 0x5c, 0x34, 0xe4, 0xcf, 0x97, 0x73, 0xc9, 0x52, 0x4c, 0xf7, 0x77, 0x60, 0x7f, 0x2b, 0xdf,
+	0xc6, 0xf7, 0xd0, 0xea, 0x48, 0x58, 0x55, 0x74, 0x52, 0x6c, 0x41, 0xa4, 0xb9, 0x5f, 0x1c, 0x66,
+	0x4e, 0x63, 0xca, 0xf0, 0x69, 0x51, 0x97, 0x5c, 0xc8, 0x31, 0x92, 0x43, 0xc6, 0xf4, 0x2b, 0x73,
+	0xdf, 0x3c, 0x72, 0x66, 0xfe, 0x78, 0xe4, 0x58, 0x6e, 0x8a, 0x6a, 0x85, 0x04, 0xe2, 0x06, 0xaa,
+	0x05, 0x4c, 0xc8, 0x30, 0xd1, 0xbd, 0xaa, 0xfb, 0x7d, 0x9e, 0x14, 0xb7, 0xf0, 0xbb, 0xa8, 0x3a,
+	0x60, 0x61, 0xb7, 0x27, 0xa1, 0x49, 0x2f, 0x9d, 0xa0, 0x49, 0x09, 0xa8, 0x5c, 0x29, 0xeb, 0x33,
+	0x1f, 0x59, 0x68, 0xa1, 0x98, 0x56, 0xbc, 0x84, 0x2a, 0x01, 0x4b, 0x78, 0x0c, 0xe7, 0x99, 0x05,
+	0x7e, 0x0b, 0x55, 0x69, 0xac, 0xea, 0x1b, 0x4e, 0xba, 0x08, 0x27, 0x2d, 0xff, 0xfd, 0xa4, 0x1b,
+	0x89, 0x24, 0x20, 0x8c, 0xdf, 0x43, 0x8b, 0x83, 0x30, 0x09, 0xf8, 0x60, 0xb2, 0x8b, 0xed, 0x71,
+	0x59, 0x4e, 0xc0, 0x2e, 0x59, 0x30, 0x6b, 0x08, 0x8d, 0x31, 0xf1, 0x3b, 0x0b, 0x2d, 0x82, 0x89,
+	0x77, 0x34, 0x7a, 0x88, 0x8d, 0x6f, 0xa3, 0x9a, 0x90, 0x34, 0x95, 0x86, 0x4c, 0x1b, 0x5a, 0x6a,
+	0xaf, 0x0c, 0x33, 0x07, 0x43, 0x72, 0xc6, 0xa0, 0x4b, 0x90, 0x5e, 0xe9, 0x73, 0xf0, 0xfb, 0xa8,
+	0x96, 0x30, 0xe9, 0x41, 0xc1, 0xda, 0xa5, 0x93, 0x78, 0x88, 0x12, 0x26, 0xc1, 0x28, 0x30, 0xf3,
+	0xd7, 0x12, 0xaa, 0xec, 0x48, 0x2a, 0x19, 0x7e, 0x68, 0xa1, 0x1a, 0xb4, 0x5b, 0x9f, 0xf3, 0xc8,
+	0xb6, 0xa0, 0x55, 0x0d, 0x53, 0x53, 0xcd, 0xfe, 0x26, 0xcc, 0xfe, 0xe6, 0x07, 0x3c, 0x4c, 0xda,
+	0xdb, 0xd0, 0x4a, 0x78, 0xa2, 0x55, 0x95, 0xae, 0xfb, 0xc3, 0x6f, 0xce, 0x66, 0x37, 0x94, 0xbd,
+	0xbd, 0x4e, 0xd3, 0xe7, 0x71, 0x0b, 0xae, 0x0f, 0xf3, 0xef, 0x35, 0x11, 0xec, 0xb6, 0xe4, 0x41,
+	0x9f, 0x09, 0x4d, 0x23, 0x08, 0x32, 0x9a, 0xb7, 0x38, 0x8f, 0xf0, 0xb7, 0x16, 0x82, 0x41, 0x62,
+	0x5c, 0xf6, 0x46, 0xf9, 0x3b, 0xc6, 0x98, 0x8f, 0xc1, 0x98, 0xb5, 0x09, 0x63, 0x8a, 0x1c, 0xa7,
+	0x33, 0xea, 0xff, 0x86, 0x41, 0x87, 0x7a, 0x6b, 0x54, 0x16, 0x11, 0x15, 0xd2, 0x13, 0xec, 0xf3,
+	0x3d, 0x96, 0xf8, 0x4c, 0x87, 0xbc, 0x5c, 0x2c, 0x8b, 0x09, 0xd8, 0x25, 0x0b, 0x6a, 0xbd, 0x03,
+	0x4b, 0x9c, 0xa0, 0xba, 0xc6, 0xc1, 0xb4, 0x20, 0x14, 0x32, 0x0d, 0x3b, 0x7b, 0xe3, 0x76, 0xb3,
+	0xcb, 0x3a, 0xf7, 0xaf, 0x8e, 0x2f, 0xa4, 0xa3, 0xe5, 0x5d, 0xb2, 0xae, 0x04, 0x4c, 0xf3, 0x5d,
+	0x2d, 0xc0, 0xda, 0x68, 0xc8, 0xef, 0xd7, 0xb3, 0xa8, 0x7c, 0x9d, 0x47, 0x01, 0x3e, 0x8b, 0x66,
+	0xc3, 0x00, 0x4a, 0x6f, 0x36, 0x0c, 0x54, 0x35, 0xf2, 0x41, 0xc2, 0x52, 0xd3, 0x1a, 0xc4, 0x2c,
+	0x30, 0x45, 0x15, 0x75, 0xaf, 0xab, 0x92, 0x3f, 0x26, 0xe0, 0xaf, 0xab, 0x80, 0x9f, 0x2a, 0xa4,
+	0x86, 0x59, 0x15, 0x3c, 0xdb, 0xef, 0x87, 0xe9, 0x81, 0x27, 0xc3, 0x98, 0xd9, 0xe5, 0xe9, 0x82,
+	0x2f, 0x80, 0x2e, 0x41, 0x66, 0x75, 0x3b, 0x8c, 0x19, 0xde, 0x46, 0xff, 0x4b, 0x59, 0xc4, 0xa8,
+	0x60, 0x1e, 0x95, 0x92, 0xc5, 0x7d, 0x69, 0x6e, 0xa7, 0xc5, 0xf6, 0xfa, 0x30, 0x73, 0x2e, 0xe4,
+	0x89, 0x9f, 0x94, 0x70, 0xc9, 0x39, 0xd8, 0xda, 0x82, 0x1d, 0x08, 0xcc, 0x0e, 0xaa, 0xdc, 0xa1,
+	0xd2, 0xef, 0x61, 0x1b, 0x9d, 0x81, 0x79, 0x0a, 0xd1, 0xc9, 0x97, 0xe3, 0x86, 0x9d, 0x2d, 0x36,
+	0xec, 0x92, 0x0a, 0x91, 0xaa, 0x49, 0x9d, 0x7e, 0x62, 0x16, 0x40, 0xfa, 0xa3, 0x85, 0xce, 0xb5,
+	0x69, 0x44, 0x13, 0x9f, 0xe5, 0x43, 0xf9, 0x08, 0xfe, 0x15, 0x54, 0xed, 0x8d, 0x07, 0x61, 0x89,
+	0xc0, 0x0a, 0x77, 0xd1, 0x5c, 0xc7, 0x90, 0xfc, 0x2b, 0x79, 0x18, 0x91, 0x83, 0xd1, 0xdf, 0x57,
+	0xf2, 0x09, 0xae, 0x6f, 0xed, 0xe9, 0x89, 0x64, 0x9d, 0x78, 0x22, 0x4d, 0x4f, 0x90, 0xd9, 0xff,
+	0x62, 0x82, 0x3c, 0x3c, 0xf2, 0x59, 0x68, 0xa6, 0xe4, 0xb5, 0x93, 0x3d, 0x0b, 0xe1, 0x7a, 0x3c,
+	0x94, 0xed, 0xf0, 0x17, 0xe1, 0x17, 0x16, 0x5a, 0x30, 0x2a, 0x30, 0xbf, 0xca, 0xc7, 0x85, 0xe2,
+	0x1a, 0x84, 0xe2, 0x3c, 0x34, 0x41, 0x41, 0xf9, 0x74, 0xb1, 0xa8, 0x69, 0x55, 0x18, 0x59, 0x31,
+	0xaa, 0x8d, 0xe6, 0x06, 0x0b, 0xec, 0xca, 0x3f, 0x5f, 0x4c, 0x45, 0x7e, 0xf5, 0x10, 0xd6, 0x23,
+	0xeb, 0x39, 0xb3, 0xad, 0x3a, 0xfd, 0x10, 0x3e, 0x44, 0xd0, 0x25, 0xcb, 0x0a, 0x39, 0x6c, 0x9c,
+	0xfd, 0x64, 0xa1, 0x39, 0x95, 0xe6, 0xed, 0x88, 0x0f, 0x30, 0x46, 0x65, 0xb8, 0xa9, 0x54, 0x5b,
+	0xe9, 0xdf, 0x78, 0x03, 0xcd, 0x07, 0x61, 0xca, 0x0a, 0x1f, 0x01, 0x64, 0xbc, 0x81, 0xd7, 0xd0,
+	0x9c, 0x4f, 0x25, 0xeb, 0xf2, 0xf4, 0xc0, 0x94, 0x02, 0x19, 0xad, 0xb1, 0x8f, 0xaa, 0x27, 0x4d,
+	0xd6, 0xe9, 0xc3, 0x04, 0xd4, 0xc6, 0x8b, 0x36, 0x79, 0xfc, 0xb4, 0x6e, 0x3d, 0x79, 0x5a, 0xb7,
+	0x7e, 0x7f, 0x5a, 0xb7, 0xbe, 0x7a, 0x56, 0x9f, 0x79, 0xf2, 0xac, 0x3e, 0xf3, 0xf3, 0xb3, 0xfa,
+	0xcc, 0xdd, 0x77, 0x0a, 0x8c, 0x5b, 0xe6, 0x93, 0xcd, 0xbc, 0x65, 0x35, 0x63, 0x97, 0x47, 0x34,
+	0xe9, 0xe6, 0x47, 0xed, 0xc3, 0xd7, 0x9c, 0x3e, 0xa7, 0x53, 0xd5, 0x9f, 0x62, 0x6f, 0xfe, 0x35,
+	0x00, 0x41, 0x6b, 0xeb, 0x5d, 0xea, 0x0d, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RewardEpochDurationBlocks != that1.RewardEpochDurationBlocks {
+		return false
+	}
+	if !this.PerEpochRewardFraction.Equal(that1.PerEpochRewardFraction) {
+		return false
+	}
+	if this.RewardSmoothingBlocks != that1.RewardSmoothingBlocks {
+		return false
+	}
+	if len(this.AllowedMonitoringAccounts) != len(that1.AllowedMonitoringAccounts) {
+		return false
+	}
+	for i := range this.AllowedMonitoringAccounts {
+		if this.AllowedMonitoringAccounts[i] != that1.AllowedMonitoringAccounts[i] {
+			return false
+		}
+	}
+	if this.RewardHistoryEpochs != that1.RewardHistoryEpochs {
+		return false
+	}
+	if len(this.RewardSplits) != len(that1.RewardSplits) {
+		return false
+	}
+	for i := range this.RewardSplits {
+		if !this.RewardSplits[i].Equal(&that1.RewardSplits[i]) {
+			return false
+		}
+	}
+	if len(this.OutflowLimits) != len(that1.OutflowLimits) {
+		return false
+	}
+	for i := range this.OutflowLimits {
+		if !this.OutflowLimits[i].Equal(&that1.OutflowLimits[i]) {
+			return false
+		}
+	}
+	if this.SnapshotIntervalBlocks != that1.SnapshotIntervalBlocks {
+		return false
+	}
+	if this.MaxSnapshotAddresses != that1.MaxSnapshotAddresses {
+		return false
+	}
+	if this.SnapshotRetentionBlocks != that1.SnapshotRetentionBlocks {
+		return false
+	}
+	return true
+}
+func (this *RewardSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardSplit)
+	if !ok {
+		that2, ok := that.(RewardSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *OutflowLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutflowLimit)
+	if !ok {
+		that2, ok := that.(OutflowLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.WindowBlocks != that1.WindowBlocks {
+		return false
+	}
+	return true
+}
+func (this *OutflowWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutflowWindow)
+	if !ok {
+		that2, ok := that.(OutflowWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.StartBlock != that1.StartBlock {
+		return false
+	}
+	if !this.NetOutflow.Equal(that1.NetOutflow) {
+		return false
+	}
+	return true
+}
+func (this *State) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*State)
+	if !ok {
+		that2, ok := that.(State)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.RewardPool) != len(that1.RewardPool) {
+		return false
+	}
+	for i := range this.RewardPool {
+		if !this.RewardPool[i].Equal(&that1.RewardPool[i]) {
+			return false
+		}
+	}
+	if len(this.RewardBlockAmount) != len(that1.RewardBlockAmount) {
+		return false
+	}
+	for i := range this.RewardBlockAmount {
+		if !this.RewardBlockAmount[i].Equal(&that1.RewardBlockAmount[i]) {
+			return false
+		}
+	}
+	if this.LastSequence != that1.LastSequence {
+		return false
+	}
+	if this.LastRewardDistributionBlock != that1.LastRewardDistributionBlock {
+		return false
+	}
+	return true
+}
+func (this *Hold) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Hold)
+	if !ok {
+		that2, ok := that.(Hold)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if len(this.Coins) != len(that1.Coins) {
+		return false
+	}
+	for i := range this.Coins {
+		if !this.Coins[i].Equal(&that1.Coins[i]) {
+			return false
+		}
+	}
+	if this.ExpiryTime != that1.ExpiryTime {
+		return false
+	}
+	if this.ReleaseAttempts != that1.ReleaseAttempts {
+		return false
+	}
+	return true
+}
+func (this *Watch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Watch)
+	if !ok {
+		that2, ok := that.(Watch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *BalanceSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BalanceSnapshot)
+	if !ok {
+		that2, ok := that.(BalanceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Balances) != len(that1.Balances) {
+		return false
+	}
+	for i := range this.Balances {
+		if !this.Balances[i].Equal(&that1.Balances[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RewardEpoch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardEpoch)
+	if !ok {
+		that2, ok := that.(RewardEpoch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartBlock != that1.StartBlock {
+		return false
+	}
+	if len(this.RewardPool) != len(that1.RewardPool) {
+		return false
+	}
+	for i := range this.RewardPool {
+		if !this.RewardPool[i].Equal(&that1.RewardPool[i]) {
+			return false
+		}
+	}
+	if !this.PerEpochRewardFraction.Equal(that1.PerEpochRewardFraction) {
+		return false
+	}
+	if len(this.EpochAmount) != len(that1.EpochAmount) {
+		return false
+	}
+	for i := range this.EpochAmount {
+		if !this.EpochAmount[i].Equal(&that1.EpochAmount[i]) {
+			return false
+		}
+	}
+	if len(this.Distributed) != len(that1.Distributed) {
+		return false
+	}
+	for i := range this.Distributed {
+		if !this.Distributed[i].Equal(&that1.Distributed[i]) {
+			return false
+		}
+	}
+	if this.LastDistributionBlock != that1.LastDistributionBlock {
+		return false
+	}
+	return true
+}
+func (this *PoolFlow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolFlow)
+	if !ok {
+		that2, ok := that.(PoolFlow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Pool != that1.Pool {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotRetentionBlocks != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.SnapshotRetentionBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxSnapshotAddresses != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.MaxSnapshotAddresses))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SnapshotIntervalBlocks != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.SnapshotIntervalBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.OutflowLimits) > 0 {
+		for iNdEx := len(m.OutflowLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutflowLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardSplits) > 0 {
+		for iNdEx := len(m.RewardSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.RewardHistoryEpochs != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.RewardHistoryEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedMonitoringAccounts) > 0 {
+		for iNdEx := len(m.AllowedMonitoringAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMonitoringAccounts[iNdEx])
+			copy(dAtA[i:], m.AllowedMonitoringAccounts[iNdEx])
+			i = encodeVarintVbank(dAtA, i, uint64(len(m.AllowedMonitoringAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RewardSmoothingBlocks != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.RewardSmoothingBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.PerEpochRewardFraction.Size()
+		i -= size
+		if _, err := m.PerEpochRewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RewardEpochDurationBlocks != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.RewardEpochDurationBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetOutflow.Size()
+		i -= size
+		if _, err := m.NetOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartBlock != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastRewardDistributionBlock != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.LastRewardDistributionBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSequence != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.LastSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for iNdEx := len(m.RewardBlockAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardBlockAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseAttempts != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.ReleaseAttempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Watch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Watch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Watch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastDistributionBlock != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.LastDistributionBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.PerEpochRewardFraction.Size()
+		i -= size
+		if _, err := m.PerEpochRewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVbank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintVbank(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVbank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintVbank(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVbank(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVbank(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RewardEpochDurationBlocks != 0 {
+		n += 1 + sovVbank(uint64(m.RewardEpochDurationBlocks))
+	}
+	l = m.PerEpochRewardFraction.Size()
+	n += 1 + l + sovVbank(uint64(l))
+	if m.RewardSmoothingBlocks != 0 {
+		n += 1 + sovVbank(uint64(m.RewardSmoothingBlocks))
+	}
+	if len(m.AllowedMonitoringAccounts) > 0 {
+		for _, s := range m.AllowedMonitoringAccounts {
+			l = len(s)
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if m.RewardHistoryEpochs != 0 {
+		n += 1 + sovVbank(uint64(m.RewardHistoryEpochs))
+	}
+	if len(m.RewardSplits) > 0 {
+		for _, e := range m.RewardSplits {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if len(m.OutflowLimits) > 0 {
+		for _, e := range m.OutflowLimits {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if m.SnapshotIntervalBlocks != 0 {
+		n += 1 + sovVbank(uint64(m.SnapshotIntervalBlocks))
+	}
+	if m.MaxSnapshotAddresses != 0 {
+		n += 1 + sovVbank(uint64(m.MaxSnapshotAddresses))
+	}
+	if m.SnapshotRetentionBlocks != 0 {
+		n += 1 + sovVbank(uint64(m.SnapshotRetentionBlocks))
+	}
+	return n
+}
+
+func (m *RewardSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovVbank(uint64(l))
+	return n
+}
+
+func (m *OutflowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVbank(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovVbank(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *OutflowWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovVbank(uint64(m.StartBlock))
+	}
+	l = m.NetOutflow.Size()
+	n += 1 + l + sovVbank(uint64(l))
+	return n
+}
+
+func (m *State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if len(m.RewardBlockAmount) > 0 {
+		for _, e := range m.RewardBlockAmount {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if m.LastSequence != 0 {
+		n += 1 + sovVbank(uint64(m.LastSequence))
+	}
+	if m.LastRewardDistributionBlock != 0 {
+		n += 1 + sovVbank(uint64(m.LastRewardDistributionBlock))
+	}
+	return n
+}
+
+func (m *Hold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovVbank(uint64(m.ExpiryTime))
+	}
+	if m.ReleaseAttempts != 0 {
+		n += 1 + sovVbank(uint64(m.ReleaseAttempts))
+	}
+	return n
+}
+
+func (m *Watch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovVbank(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *BalanceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVbank(uint64(m.Height))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		n += 1 + sovVbank(uint64(m.StartBlock))
+	}
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	l = m.PerEpochRewardFraction.Size()
+	n += 1 + l + sovVbank(uint64(l))
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	if m.LastDistributionBlock != 0 {
+		n += 1 + sovVbank(uint64(m.LastDistributionBlock))
+	}
+	return n
+}
+
+func (m *PoolFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovVbank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVbank(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVbank(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVbank(x uint64) (n int) {
+	return sovVbank(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochDurationBlocks", wireType)
+			}
+			m.RewardEpochDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEpochDurationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerEpochRewardFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerEpochRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSmoothingBlocks", wireType)
+			}
+			m.RewardSmoothingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardSmoothingBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMonitoringAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMonitoringAccounts = append(m.AllowedMonitoringAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistoryEpochs", wireType)
+			}
+			m.RewardHistoryEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardHistoryEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardSplits = append(m.RewardSplits, RewardSplit{})
+			if err := m.RewardSplits[len(m.RewardSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutflowLimits = append(m.OutflowLimits, OutflowLimit{})
+			if err := m.OutflowLimits[len(m.OutflowLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotIntervalBlocks", wireType)
+			}
+			m.SnapshotIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotIntervalBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotAddresses", wireType)
+			}
+			m.MaxSnapshotAddresses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSnapshotAddresses |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionBlocks", wireType)
+			}
+			m.SnapshotRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBlockAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardBlockAmount = append(m.RewardBlockAmount, types.Coin{})
+			if err := m.RewardBlockAmount[len(m.RewardBlockAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSequence", wireType)
+			}
+			m.LastSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardDistributionBlock", wireType)
+			}
+			m.LastRewardDistributionBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardDistributionBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAttempts", wireType)
+			}
+			m.ReleaseAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Watch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Watch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Watch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVbank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVbank(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.RewardPool) > 0 {
-		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVbank(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVbank
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintVbank(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVbank(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RewardEpochDurationBlocks != 0 {
-		n += 1 + sovVbank(uint64(m.RewardEpochDurationBlocks))
-	}
-	l = m.PerEpochRewardFraction.Size()
-	n += 1 + l + sovVbank(uint64(l))
-	if m.RewardSmoothingBlocks != 0 {
-		n += 1 + sovVbank(uint64(m.RewardSmoothingBlocks))
-	}
-	if len(m.AllowedMonitoringAccounts) > 0 {
-		for _, s := range m.AllowedMonitoringAccounts {
-			l = len(s)
-			n += 1 + l + sovVbank(uint64(l))
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *State) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RewardPool) > 0 {
-		for _, e := range m.RewardPool {
-			l = e.Size()
-			n += 1 + l + sovVbank(uint64(l))
-		}
-	}
-	if len(m.RewardBlockAmount) > 0 {
-		for _, e := range m.RewardBlockAmount {
-			l = e.Size()
-			n += 1 + l + sovVbank(uint64(l))
-		}
-	}
-	if m.LastSequence != 0 {
-		n += 1 + sovVbank(uint64(m.LastSequence))
-	}
-	if m.LastRewardDistributionBlock != 0 {
-		n += 1 + sovVbank(uint64(m.LastRewardDistributionBlock))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovVbank(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVbank(x uint64) (n int) {
-	return sovVbank(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *RewardEpoch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -507,17 +3461,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardEpoch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochDurationBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 			}
-			m.RewardEpochDurationBlocks = 0
+			m.StartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -527,12 +3481,46 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardEpochDurationBlocks |= int64(b&0x7F) << shift
+				m.StartBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PerEpochRewardFraction", wireType)
 			}
@@ -566,11 +3554,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardSmoothingBlocks", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
 			}
-			m.RewardSmoothingBlocks = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -580,16 +3568,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardSmoothingBlocks |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMonitoringAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -599,24 +3602,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVbank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVbank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedMonitoringAccounts = append(m.AllowedMonitoringAccounts, string(dAtA[iNdEx:postIndex]))
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionBlock", wireType)
+			}
+			m.LastDistributionBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVbank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDistributionBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVbank(dAtA[iNdEx:])
@@ -638,7 +3662,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
+func (m *PoolFlow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -661,17 +3685,17 @@ func (m *State) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolFlow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolFlow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -681,31 +3705,29 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVbank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVbank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardPool = append(m.RewardPool, types.Coin{})
-			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBlockAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -715,31 +3737,29 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVbank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVbank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardBlockAmount = append(m.RewardBlockAmount, types.Coin{})
-			if err := m.RewardBlockAmount[len(m.RewardBlockAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
-			m.LastSequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -749,16 +3769,29 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastSequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardDistributionBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.LastRewardDistributionBlock = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVbank
@@ -768,11 +3801,26 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRewardDistributionBlock |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVbank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVbank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVbank(dAtA[iNdEx:])
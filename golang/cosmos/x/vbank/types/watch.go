@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateWatchTarget checks that denom of address (or every denom, if it is
+// WatchAllDenoms) can be watched.
+func ValidateWatchTarget(address, denom string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return fmt.Errorf("cannot convert %s to address: %s", address, err)
+	}
+	if denom == WatchAllDenoms {
+		return nil
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %s", denom, err)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of a Watch.
+func (w Watch) ValidateBasic() error {
+	if err := ValidateWatchTarget(w.Address, w.Denom); err != nil {
+		return err
+	}
+	if w.Count == 0 {
+		return fmt.Errorf("watch of %s in %s has no registrations", w.Address, w.Denom)
+	}
+	return nil
+}
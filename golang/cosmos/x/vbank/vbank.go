@@ -2,7 +2,6 @@ package vbank
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	stdlog "log"
 	"sort"
@@ -11,6 +10,15 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
+)
+
+var (
+	_ vm.MultiEncodingPortHandler = portHandler{}
+	_ vm.PortDescriber            = portHandler{}
 )
 
 type portHandler struct {
@@ -26,6 +34,86 @@ type portMessage struct { // comes from swingset's vat-bank
 	ModuleName string `json:"moduleName"`
 	Denom      string `json:"denom"`
 	Amount     string `json:"amount"`
+	// Moves are the balance changes of a VBANK_*_MANY message.
+	Moves []vbankMove `json:"moves,omitempty"`
+	// HoldID identifies the hold of a VBANK_*_HOLD message.
+	HoldID string `json:"holdId,omitempty"`
+	// Coins are the coins to hold for VBANK_CREATE_HOLD.
+	Coins []vbankCoin `json:"coins,omitempty"`
+	// Expiry is the block time (in seconds since the Unix epoch) at which a
+	// VBANK_CREATE_HOLD hold is released automatically, or 0 for never.
+	Expiry int64 `json:"expiry,omitempty"`
+	// Height is the block of a VBANK_GET_BALANCES_AT message.
+	Height int64 `json:"height,omitempty"`
+}
+
+// vbankMove is a single entry of a VBANK_*_MANY message.  Sender is used by
+// VBANK_GRAB_MANY, Recipient by VBANK_GIVE_MANY, and both by
+// VBANK_TRANSFER_MANY.
+type vbankMove struct {
+	Sender    string      `json:"sender,omitempty"`
+	Recipient string      `json:"recipient,omitempty"`
+	Coins     []vbankCoin `json:"coins"`
+}
+
+type vbankCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// vbankDenomMetadata is the reply to VBANK_GET_DENOM_METADATA.  Exponent is
+// that of the display unit.
+type vbankDenomMetadata struct {
+	Base        string           `json:"base"`
+	Display     string           `json:"display"`
+	Exponent    uint32           `json:"exponent"`
+	Name        string           `json:"name,omitempty"`
+	Symbol      string           `json:"symbol,omitempty"`
+	Description string           `json:"description,omitempty"`
+	DenomUnits  []vbankDenomUnit `json:"denomUnits"`
+}
+
+type vbankDenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases,omitempty"`
+}
+
+// vbankDenomTrace is the reply to VBANK_RESOLVE_IBC_DENOM.  Path is the full
+// ICS-20 denom path (such as "transfer/channel-0/uatom"), which for a native
+// denom is just its base with an empty Trace.
+type vbankDenomTrace struct {
+	Denom     string     `json:"denom"`
+	BaseDenom string     `json:"baseDenom"`
+	Path      string     `json:"path"`
+	Trace     []vbankHop `json:"trace"`
+}
+
+type vbankHop struct {
+	PortID    string `json:"portId"`
+	ChannelID string `json:"channelId"`
+}
+
+// vbankBalanceSnapshot is the reply to VBANK_GET_BALANCES_AT.  Height is that
+// of the snapshot giving the balances, which may precede the requested one.
+type vbankBalanceSnapshot struct {
+	Address  string      `json:"address"`
+	Height   int64       `json:"height"`
+	Balances []vbankCoin `json:"balances"`
+}
+
+// poolFlowCategories maps the message types that move coins to the category
+// under which those movements are accounted.
+var poolFlowCategories = map[string]string{
+	"VBANK_GIVE":          agoric.PoolFlowCategoryVbankGive,
+	"VBANK_GIVE_MANY":     agoric.PoolFlowCategoryVbankGive,
+	"VBANK_GRAB":          agoric.PoolFlowCategoryVbankGrab,
+	"VBANK_GRAB_MANY":     agoric.PoolFlowCategoryVbankGrab,
+	"VBANK_TRANSFER_MANY": agoric.PoolFlowCategoryVbankTransfer,
+}
+
+func init() {
+	vm.RegisterPortMessage("bank", portMessage{})
 }
 
 func NewPortHandler(am AppModule, keeper Keeper) portHandler {
@@ -74,6 +162,17 @@ type VbankBalanceUpdate struct {
 	Updated          vbankManyBalanceUpdates `json:"updated"`
 }
 
+// VbankHoldsExpired tells the VM which holds were released to their owners
+// because their expiry time passed.
+type VbankHoldsExpired struct {
+	*vm.ActionHeader `actionType:"VBANK_HOLDS_EXPIRED"`
+	HoldIDs          []string `json:"holdIds"`
+}
+
+func init() {
+	vm.RegisterActions(&VbankBalanceUpdate{}, &VbankHoldsExpired{})
+}
+
 // getBalanceUpdate returns a bridge message containing the current bank balance
 // for the given addresses each for the specified denominations. Coins are used
 // only to track the set of denoms, not for the particular nonzero amounts.
@@ -119,11 +218,45 @@ func getBalanceUpdate(ctx sdk.Context, keeper Keeper, addressToUpdate map[string
 	return vm.PopulateAction(ctx, event), nil
 }
 
-func marshal(event vm.Jsonable) ([]byte, error) {
+func marshal(enc vm.Encoding, event vm.Jsonable) ([]byte, error) {
 	if event == nil {
 		return nil, nil
 	}
-	return json.Marshal(event)
+	str, err := vm.MarshalData(enc, event)
+	return []byte(str), err
+}
+
+// Encodings implements vm.MultiEncodingPortHandler.  Balance updates are
+// among the highest-volume bridge traffic, so vbank accepts CBOR.
+func (ch portHandler) Encodings() []vm.Encoding {
+	return []vm.Encoding{vm.EncodingCBOR}
+}
+
+// DescribePort implements vm.PortDescriber.
+func (ch portHandler) DescribePort() vm.PortCapabilities {
+	return vm.PortCapabilities{
+		Version: "1",
+		Methods: []string{
+			"VBANK_GET_BALANCE",
+			"VBANK_GRAB",
+			"VBANK_GIVE",
+			"VBANK_GRAB_MANY",
+			"VBANK_GIVE_MANY",
+			"VBANK_TRANSFER_MANY",
+			"VBANK_CREATE_HOLD",
+			"VBANK_RELEASE_HOLD",
+			"VBANK_CAPTURE_HOLD",
+			"VBANK_WATCH",
+			"VBANK_UNWATCH",
+			"VBANK_GIVE_TO_REWARD_DISTRIBUTOR",
+			"VBANK_GET_MODULE_ACCOUNT_ADDRESS",
+			"VBANK_GET_DENOM_METADATA",
+			"VBANK_RESOLVE_IBC_DENOM",
+			"VBANK_REGISTER_SNAPSHOTS",
+			"VBANK_UNREGISTER_SNAPSHOTS",
+			"VBANK_GET_BALANCES_AT",
+		},
+	}
 }
 
 func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err error) {
@@ -132,10 +265,13 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 	keeper := ch.keeper
 
 	var msg portMessage
-	err = json.Unmarshal([]byte(str), &msg)
+	enc, err := vm.UnmarshalData(str, &msg)
 	if err != nil {
 		return ret, err
 	}
+	if category, ok := poolFlowCategories[msg.Type]; ok {
+		ctx = agoric.WithPoolFlowCategory(ctx, category)
+	}
 
 	switch msg.Type {
 	case "VBANK_GET_BALANCE":
@@ -148,9 +284,9 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 		}
 		coin := keeper.GetBalance(ctx, addr, msg.Denom)
 		packet := coin.Amount.String()
-		bytes, err := json.Marshal(&packet)
-		if err == nil {
-			ret = string(bytes)
+		ret, err = vm.MarshalData(enc, &packet)
+		if err != nil {
+			return "", err
 		}
 
 	case "VBANK_GRAB":
@@ -175,15 +311,14 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 		if err != nil {
 			return "", err
 		}
-		bz, err := marshal(action)
+		bz, err := marshal(enc, action)
 		if err != nil {
 			return "", err
 		}
 		if bz == nil {
-			ret = "true"
-		} else {
-			ret = string(bz)
+			return vm.MarshalData(enc, true)
 		}
+		ret = string(bz)
 
 	case "VBANK_GIVE":
 		addr, err := sdk.AccAddressFromBech32(msg.Recipient)
@@ -207,15 +342,86 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 		if err != nil {
 			return "", err
 		}
-		bz, err := marshal(action)
+		bz, err := marshal(enc, action)
 		if err != nil {
 			return "", err
 		}
 		if bz == nil {
-			ret = "true"
-		} else {
-			ret = string(bz)
+			return vm.MarshalData(enc, true)
+		}
+		ret = string(bz)
+
+	case "VBANK_GIVE_MANY", "VBANK_GRAB_MANY", "VBANK_TRANSFER_MANY":
+		addressToBalances, err := executeMoves(ctx, keeper, msg.Type, msg.Moves)
+		if err != nil {
+			return "", err
+		}
+		action, err := getBalanceUpdate(ctx, keeper, addressToBalances)
+		if err != nil {
+			return "", err
+		}
+		bz, err := marshal(enc, action)
+		if err != nil {
+			return "", err
+		}
+		if bz == nil {
+			return vm.MarshalData(enc, true)
+		}
+		ret = string(bz)
+
+	case "VBANK_CREATE_HOLD":
+		coins, err := parseCoins(msg.Coins)
+		if err != nil {
+			return "", err
+		}
+		hold := types.Hold{Id: msg.HoldID, Owner: msg.Sender, Coins: coins, ExpiryTime: msg.Expiry}
+		if err := keeper.CreateHold(ctx, hold); err != nil {
+			return "", fmt.Errorf("cannot create hold %q of %s coins: %s", msg.HoldID, coins.String(), err)
+		}
+		action, err := getBalanceUpdate(ctx, keeper, holdBalances(hold))
+		if err != nil {
+			return "", err
+		}
+		ret, err = vm.MarshalData(enc, action)
+		if err != nil {
+			return "", err
+		}
+
+	case "VBANK_RELEASE_HOLD":
+		hold, err := keeper.ReleaseHold(ctx, msg.HoldID)
+		if err != nil {
+			return "", fmt.Errorf("cannot release hold %q: %s", msg.HoldID, err)
+		}
+		action, err := getBalanceUpdate(ctx, keeper, holdBalances(hold))
+		if err != nil {
+			return "", err
+		}
+		ret, err = vm.MarshalData(enc, action)
+		if err != nil {
+			return "", err
+		}
+
+	case "VBANK_CAPTURE_HOLD":
+		// The owner's balance does not change, since the coins already left it
+		// when the hold was created.
+		if _, err := keeper.CaptureHold(ctx, msg.HoldID); err != nil {
+			return "", fmt.Errorf("cannot capture hold %q: %s", msg.HoldID, err)
+		}
+		return vm.MarshalData(enc, true)
+
+	case "VBANK_WATCH":
+		count, err := keeper.AddWatch(ctx, msg.Address, msg.Denom)
+		if err != nil {
+			return "", fmt.Errorf("cannot watch: %s", err)
 		}
+		return vm.MarshalData(enc, count)
+
+	case "VBANK_UNWATCH":
+		count, err := keeper.RemoveWatch(ctx, msg.Address, msg.Denom)
+		if err != nil {
+			return "", fmt.Errorf("cannot unwatch: %s", err)
+		}
+		return vm.MarshalData(enc, count)
 
 	case "VBANK_GIVE_TO_REWARD_DISTRIBUTOR":
 		value, ok := sdkmath.NewIntFromString(msg.Amount)
@@ -236,19 +442,80 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 			return "", err
 		}
 		// We don't supply the module balance, since the controller shouldn't know.
-		ret = "true"
+		return vm.MarshalData(enc, true)
 
 	case "VBANK_GET_MODULE_ACCOUNT_ADDRESS":
 		addr := keeper.GetModuleAccountAddress(ctx, msg.ModuleName).String()
 		if len(addr) == 0 {
 			return "", fmt.Errorf("module account %s not found", msg.ModuleName)
 		}
-		bz, err := marshal(addr)
+		bz, err := marshal(enc, addr)
 		if err != nil {
 			return "", err
 		}
 		ret = string(bz)
 
+	case "VBANK_GET_DENOM_METADATA":
+		if err = sdk.ValidateDenom(msg.Denom); err != nil {
+			return "", fmt.Errorf("invalid denom %s: %s", msg.Denom, err)
+		}
+		metadata, found, err := keeper.GetDenomMetadata(ctx, msg.Denom)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			return vm.MarshalData(enc, nil)
+		}
+		return vm.MarshalData(enc, denomMetadataReply(metadata))
+
+	case "VBANK_RESOLVE_IBC_DENOM":
+		denom, err := keeper.ResolveIBCDenom(ctx, msg.Denom)
+		if err != nil {
+			return "", err
+		}
+		reply := vbankDenomTrace{
+			Denom:     msg.Denom,
+			BaseDenom: denom.Base,
+			Path:      denom.Path(),
+			Trace:     make([]vbankHop, len(denom.Trace)),
+		}
+		for i, hop := range denom.Trace {
+			reply.Trace[i] = vbankHop{PortID: hop.PortId, ChannelID: hop.ChannelId}
+		}
+		return vm.MarshalData(enc, reply)
+
+	case "VBANK_REGISTER_SNAPSHOTS":
+		if err := keeper.RegisterSnapshots(ctx, msg.Address); err != nil {
+			return "", fmt.Errorf("cannot register snapshots: %s", err)
+		}
+		return vm.MarshalData(enc, true)
+
+	case "VBANK_UNREGISTER_SNAPSHOTS":
+		if err := keeper.UnregisterSnapshots(ctx, msg.Address); err != nil {
+			return "", fmt.Errorf("cannot unregister snapshots: %s", err)
+		}
+		return vm.MarshalData(enc, true)
+
+	case "VBANK_GET_BALANCES_AT":
+		// The snapshot of the current block is only taken once the VM has
+		// finished it.
+		if msg.Height >= ctx.BlockHeight() {
+			return "", fmt.Errorf("cannot get balances: block %d has not ended", msg.Height)
+		}
+		snapshot, err := keeper.GetBalancesAt(ctx, msg.Address, msg.Height)
+		if err != nil {
+			return "", fmt.Errorf("cannot get balances: %s", err)
+		}
+		reply := vbankBalanceSnapshot{
+			Address:  snapshot.Address,
+			Height:   snapshot.Height,
+			Balances: make([]vbankCoin, len(snapshot.Balances)),
+		}
+		for i, coin := range snapshot.Balances {
+			reply.Balances[i] = vbankCoin{Denom: coin.Denom, Amount: coin.Amount.String()}
+		}
+		return vm.MarshalData(enc, reply)
+
 	default:
 		err = fmt.Errorf("unrecognized type %s", msg.Type)
 	}
@@ -257,12 +524,152 @@ func (ch portHandler) Receive(cctx context.Context, str string) (ret string, err
 	return
 }
 
+// denomMetadataReply converts bank metadata for the VM.
+func denomMetadataReply(metadata banktypes.Metadata) vbankDenomMetadata {
+	reply := vbankDenomMetadata{
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		Description: metadata.Description,
+		DenomUnits:  make([]vbankDenomUnit, len(metadata.DenomUnits)),
+	}
+	for i, unit := range metadata.DenomUnits {
+		reply.DenomUnits[i] = vbankDenomUnit{Denom: unit.Denom, Exponent: unit.Exponent, Aliases: unit.Aliases}
+		if unit.Denom == metadata.Display {
+			reply.Exponent = unit.Exponent
+		}
+	}
+	return reply
+}
+
+// holdBalances returns the balances to report when a hold changes.
+func holdBalances(hold types.Hold) map[string]sdk.Coins {
+	denoms := sdk.NewCoins()
+	for _, coin := range hold.Coins {
+		denoms = denoms.Add(sdk.NewInt64Coin(coin.Denom, 1))
+	}
+	return map[string]sdk.Coins{hold.Owner: denoms}
+}
+
+// parseCoins returns the valid, nonempty coins of a bridge message.
+func parseCoins(vcoins []vbankCoin) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	for _, c := range vcoins {
+		value, ok := sdkmath.NewIntFromString(c.Amount)
+		if !ok {
+			return nil, fmt.Errorf("cannot convert %s to int", c.Amount)
+		}
+		coin := sdk.Coin{Denom: c.Denom, Amount: value}
+		if err := coin.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid coin %s%s: %s", c.Amount, c.Denom, err)
+		}
+		coins = coins.Add(coin)
+	}
+	if !coins.IsAllPositive() {
+		return nil, fmt.Errorf("no coins")
+	}
+	return coins, nil
+}
+
+// executeMoves performs every move of a VBANK_*_MANY message of the given type,
+// or none of them if any fails.  It returns the denoms to report in a balance
+// update for each address.
+func executeMoves(ctx sdk.Context, keeper Keeper, msgType string, moves []vbankMove) (addressToBalances map[string]sdk.Coins, err error) {
+	if len(moves) == 0 {
+		return nil, fmt.Errorf("%s requires at least one move", msgType)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	defer func() {
+		if err == nil {
+			return
+		}
+		// Report a tripped outflow limit even though the moves are undone.
+		for _, event := range cacheCtx.EventManager().Events() {
+			if event.Type == types.EventTypeOutflowLimitTripped {
+				ctx.EventManager().EmitEvent(event)
+			}
+		}
+	}()
+	addressToBalances = make(map[string]sdk.Coins)
+	noteUpdate := func(address string, coins sdk.Coins) {
+		for _, coin := range coins {
+			addressToBalances[address] = addressToBalances[address].Add(sdk.NewInt64Coin(coin.Denom, 1))
+		}
+	}
+	parseAddress := func(i int, address string) (sdk.AccAddress, error) {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, fmt.Errorf("move %d: cannot convert %s to address: %s", i, address, err)
+		}
+		return addr, nil
+	}
+
+	for i, move := range moves {
+		coins, err := parseCoins(move.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("move %d: %s", i, err)
+		}
+		switch msgType {
+		case "VBANK_GIVE_MANY":
+			recipient, err := parseAddress(i, move.Recipient)
+			if err != nil {
+				return nil, err
+			}
+			if err := keeper.SendCoins(cacheCtx, recipient, coins); err != nil {
+				return nil, fmt.Errorf("move %d: cannot give %s coins: %s", i, coins.String(), err)
+			}
+			noteUpdate(move.Recipient, coins)
+
+		case "VBANK_GRAB_MANY":
+			sender, err := parseAddress(i, move.Sender)
+			if err != nil {
+				return nil, err
+			}
+			if err := keeper.GrabCoins(cacheCtx, sender, coins); err != nil {
+				return nil, fmt.Errorf("move %d: cannot grab %s coins: %s", i, coins.String(), err)
+			}
+			noteUpdate(move.Sender, coins)
+
+		case "VBANK_TRANSFER_MANY":
+			sender, err := parseAddress(i, move.Sender)
+			if err != nil {
+				return nil, err
+			}
+			recipient, err := parseAddress(i, move.Recipient)
+			if err != nil {
+				return nil, err
+			}
+			if err := keeper.TransferCoins(cacheCtx, sender, recipient, coins); err != nil {
+				return nil, fmt.Errorf("move %d: cannot transfer %s coins: %s", i, coins.String(), err)
+			}
+			noteUpdate(move.Sender, coins)
+			noteUpdate(move.Recipient, coins)
+		}
+	}
+
+	writeCache()
+	return addressToBalances, nil
+}
+
+// Message indices of the actions that vbank pushes in EndBlock, which must be
+// distinct within a block.
+const (
+	balanceUpdateMsgIdx = 0
+	holdsExpiredMsgIdx  = 1
+)
+
 func (am AppModule) PushAction(ctx sdk.Context, action vm.Action) error {
+	return am.pushActionWithMsgIdx(ctx, balanceUpdateMsgIdx, action)
+}
+
+func (am AppModule) pushActionWithMsgIdx(ctx sdk.Context, msgIdx int, action vm.Action) error {
 	// vbank actions are not triggered by a swingset message in a transaction, so we need to
 	// synthesize unique context information.
-	// We use a fixed placeholder value for the txHash context, and can simply use `0` for the
-	// message index as there is only one such action per block.
+	// We use a fixed placeholder value for the txHash context, and a fixed message index
+	// for each kind of action, as there is at most one of each per block.
 	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxHashContextKey, "x/vbank"))
-	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxMsgIdxContextKey, 0))
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), baseapp.TxMsgIdxContextKey, msgIdx))
 	return am.keeper.PushAction(ctx, action)
 }
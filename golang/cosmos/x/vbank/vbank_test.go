@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
@@ -20,8 +22,10 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/app/params"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	vbankkeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/keeper"
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vbank/types"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,6 +33,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 )
 
 var (
@@ -182,7 +187,7 @@ func Test_marshalBalanceUpdate(t *testing.T) {
 			if err != nil {
 				t.Errorf("getBalanceUpdate() error = %v, wantErr %v", err, tt.wantErr)
 			}
-			encoded, err := marshal(balanceUpdate)
+			encoded, err := marshal(vm.EncodingJSON, balanceUpdate)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("marshalBalanceUpdate() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -207,6 +212,12 @@ type mockBank struct {
 	calls []string
 	// balances for each address
 	balances map[string]sdk.Coins
+	// metadata for each denom
+	metadata map[string]banktypes.Metadata
+	// sendToAccountErr, if set, fails SendCoinsFromModuleToAccount
+	sendToAccountErr error
+	// restriction is the last appended send restriction
+	restriction banktypes.SendRestrictionFn
 }
 
 var _ types.BankKeeper = (*mockBank)(nil)
@@ -217,6 +228,7 @@ func (b *mockBank) record(s string) {
 
 func (b *mockBank) AppendSendRestriction(restriction banktypes.SendRestrictionFn) {
 	b.record("AppendSendRestriction")
+	b.restriction = restriction
 }
 
 func (b *mockBank) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
@@ -242,11 +254,22 @@ func (b *mockBank) GetBalance(_ context.Context, addr sdk.AccAddress, denom stri
 	return sdk.NewCoin(denom, amount)
 }
 
+func (b *mockBank) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	b.record(fmt.Sprintf("GetDenomMetaData %s", denom))
+	metadata, ok := b.metadata[denom]
+	return metadata, ok
+}
+
 func (b *mockBank) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
 	b.record(fmt.Sprintf("MintCoins %s %s", moduleName, amt))
 	return nil
 }
 
+func (b *mockBank) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	b.record(fmt.Sprintf("SendCoins %s %s %s", fromAddr, toAddr, amt))
+	return nil
+}
+
 func (b *mockBank) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
 	b.record(fmt.Sprintf("SendCoinsFromAccountToModule %s %s %s", senderAddr, recipientModule, amt))
 
@@ -272,7 +295,7 @@ func (b *mockBank) SendCoinsFromAccountToModule(_ context.Context, senderAddr sd
 
 func (b *mockBank) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
 	b.record(fmt.Sprintf("SendCoinsFromModuleToAccount %s %s %s", senderModule, recipientAddr, amt))
-	return nil
+	return b.sendToAccountErr
 }
 
 func (b *mockBank) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
@@ -399,6 +422,52 @@ func Test_Receive_Give(t *testing.T) {
 	}
 }
 
+func Test_Receive_GiveCBOR(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("urun", 1000)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := vm.WithEncoding(sdk.WrapSDKContext(ctx), vm.EncodingCBOR)
+
+	req, err := vm.MarshalData(vm.EncodingCBOR, portMessage{
+		Type:      "VBANK_GIVE",
+		Recipient: addr1,
+		Amount:    "1000",
+		Denom:     "urun",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ret, err := ch.Receive(ctlCtx, req)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if enc := vm.DetectEncoding(ret); enc != vm.EncodingCBOR {
+		t.Fatalf("got reply encoding %q, want %q", enc, vm.EncodingCBOR)
+	}
+	balanceUpdate := VbankBalanceUpdate{}
+	if _, err := vm.UnmarshalData(ret, &balanceUpdate); err != nil {
+		t.Fatalf("decode balance update error = %v", err)
+	}
+	// Re-encode as JSON to compare with the JSON reply.
+	encoded, err := marshal(vm.EncodingJSON, balanceUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, gotNonce, err := decodeBalances(encoded)
+	if err != nil {
+		t.Fatalf("decode balances error = %v", err)
+	}
+	want := newBalances(account(addr1, coin("urun", "1000")))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if gotNonce != 1 {
+		t.Errorf("got nonce %+v, want %+v", gotNonce, 1)
+	}
+}
+
 func Test_Receive_GiveToRewardDistributor(t *testing.T) {
 	bank := &mockBank{}
 	keeper, ctx := makeTestKit(nil, bank)
@@ -959,3 +1028,834 @@ func Test_Receive_Grab_InsufficientFunds(t *testing.T) {
 		})
 	}
 }
+
+func Test_Receive_Many(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000), sdk.NewInt64Coin("urun", 50)),
+		addr2: sdk.NewCoins(sdk.NewInt64Coin("urun", 4000)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	tests := []struct {
+		name      string
+		msg       string
+		wantCalls []string
+		want      balances
+	}{
+		{
+			name: "give",
+			msg: `{"type": "VBANK_GIVE_MANY", "moves": [
+				{"recipient": "` + addr1 + `", "coins": [{"denom": "ubld", "amount": "10"}, {"denom": "urun", "amount": "5"}]},
+				{"recipient": "` + addr2 + `", "coins": [{"denom": "urun", "amount": "7"}]}
+			]}`,
+			wantCalls: []string{
+				"MintCoins vbank 10ubld,5urun",
+				"SendCoinsFromModuleToAccount vbank " + addr1 + " 10ubld,5urun",
+				"MintCoins vbank 7urun",
+				"SendCoinsFromModuleToAccount vbank " + addr2 + " 7urun",
+			},
+			want: newBalances(
+				account(addr1, coin("ubld", "1000"), coin("urun", "50")),
+				account(addr2, coin("urun", "4000")),
+			),
+		},
+		{
+			name: "grab",
+			msg: `{"type": "VBANK_GRAB_MANY", "moves": [
+				{"sender": "` + addr1 + `", "coins": [{"denom": "ubld", "amount": "500"}]}
+			]}`,
+			wantCalls: []string{
+				"SendCoinsFromAccountToModule " + addr1 + " vbank 500ubld",
+				"BurnCoins vbank 500ubld",
+			},
+			want: newBalances(account(addr1, coin("ubld", "1000"))),
+		},
+		{
+			name: "transfer",
+			msg: `{"type": "VBANK_TRANSFER_MANY", "moves": [
+				{"sender": "` + addr2 + `", "recipient": "` + addr1 + `", "coins": [{"denom": "urun", "amount": "300"}]}
+			]}`,
+			wantCalls: []string{
+				"SendCoins " + addr2 + " " + addr1 + " 300urun",
+			},
+			want: newBalances(
+				account(addr1, coin("urun", "50")),
+				account(addr2, coin("urun", "4000")),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bank.calls = []string{}
+			ret, err := ch.Receive(ctlCtx, tt.msg)
+			if err != nil {
+				t.Fatalf("got error = %v", err)
+			}
+			got, _, err := decodeBalances([]byte(ret))
+			if err != nil {
+				t.Fatalf("decode balances error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+			// Balances are looked up in map order, so ignore those calls.
+			gotCalls := []string{}
+			for _, call := range bank.calls {
+				if !strings.HasPrefix(call, "GetBalance ") {
+					gotCalls = append(gotCalls, call)
+				}
+			}
+			if !reflect.DeepEqual(gotCalls, tt.wantCalls) {
+				t.Errorf("got calls %v, want %v", gotCalls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func Test_Receive_Many_Atomic(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000)),
+		addr2: sdk.NewCoins(sdk.NewInt64Coin("ubld", 100)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	tests := []struct {
+		name    string
+		msg     string
+		wantErr string
+	}{
+		{
+			name:    "no moves",
+			msg:     `{"type": "VBANK_GRAB_MANY", "moves": []}`,
+			wantErr: "VBANK_GRAB_MANY requires at least one move",
+		},
+		{
+			name: "insufficient funds",
+			msg: `{"type": "VBANK_GRAB_MANY", "moves": [
+				{"sender": "` + addr1 + `", "coins": [{"denom": "ubld", "amount": "500"}]},
+				{"sender": "` + addr2 + `", "coins": [{"denom": "ubld", "amount": "500"}]}
+			]}`,
+			wantErr: fmt.Sprintf("move 1: cannot grab 500ubld coins: spendable balance 100ubld is smaller than 500ubld: %s",
+				sdkerrors.ErrInsufficientFunds.Error()),
+		},
+		{
+			name: "bad address",
+			msg: `{"type": "VBANK_TRANSFER_MANY", "moves": [
+				{"sender": "` + addr1 + `", "recipient": "` + addr2 + `", "coins": [{"denom": "ubld", "amount": "5"}]},
+				{"sender": "` + addr1 + `", "recipient": "agoric1bogus", "coins": [{"denom": "ubld", "amount": "5"}]}
+			]}`,
+			wantErr: "move 1: cannot convert agoric1bogus to address",
+		},
+		{
+			name: "bad amount",
+			msg: `{"type": "VBANK_GIVE_MANY", "moves": [
+				{"recipient": "` + addr1 + `", "coins": [{"denom": "ubld", "amount": "-5"}]}
+			]}`,
+			wantErr: "move 0: invalid coin -5ubld",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, err := keeper.GetNextSequence(ctx)
+			if err != nil {
+				t.Fatalf("got error = %v", err)
+			}
+			_, err = ch.Receive(ctlCtx, tt.msg)
+			if err == nil {
+				t.Fatalf("got no error, want %q", tt.wantErr)
+			}
+			if !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("got error %q, want %q", err.Error(), tt.wantErr)
+			}
+			// The failed batch must not have consumed a balance update nonce.
+			after, err := keeper.GetNextSequence(ctx)
+			if err != nil {
+				t.Fatalf("got error = %v", err)
+			}
+			if after != before+1 {
+				t.Errorf("got sequence %d after %d, want consecutive", after, before)
+			}
+		})
+	}
+}
+
+func Test_Receive_Holds(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("ubld", 1000)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	ret, err := ch.Receive(ctlCtx, `{
+		"type": "VBANK_CREATE_HOLD",
+		"holdId": "h1",
+		"sender": "`+addr1+`",
+		"coins": [{"denom": "ubld", "amount": "500"}],
+		"expiry": 1234
+		}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	got, _, err := decodeBalances([]byte(ret))
+	if err != nil {
+		t.Fatalf("decode balances error = %v", err)
+	}
+	if want := newBalances(account(addr1, coin("ubld", "1000"))); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	hold, found, err := keeper.GetHold(ctx, "h1")
+	if err != nil || !found {
+		t.Fatalf("got hold found=%v, err=%v", found, err)
+	}
+	wantHold := types.Hold{Id: "h1", Owner: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("ubld", 500)), ExpiryTime: 1234}
+	if !hold.Equal(wantHold) {
+		t.Errorf("got hold %+v, want %+v", hold, wantHold)
+	}
+
+	if _, err := ch.Receive(ctlCtx, `{"type": "VBANK_CREATE_HOLD", "holdId": "h1", "sender": "`+addr1+`", "coins": [{"denom": "ubld", "amount": "1"}]}`); err == nil {
+		t.Errorf("got no error creating a duplicate hold")
+	}
+
+	bank.calls = []string{}
+	ret, err = ch.Receive(ctlCtx, `{"type": "VBANK_CAPTURE_HOLD", "holdId": "h1"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if ret != "true" {
+		t.Errorf("got %v, want true", ret)
+	}
+	wantCalls := []string{
+		"SendCoinsFromModuleToModule vbank/hold vbank 500ubld",
+		"BurnCoins vbank 500ubld",
+	}
+	if !reflect.DeepEqual(bank.calls, wantCalls) {
+		t.Errorf("got calls %v, want %v", bank.calls, wantCalls)
+	}
+
+	_, err = ch.Receive(ctlCtx, `{"type": "VBANK_RELEASE_HOLD", "holdId": "h1"}`)
+	if err == nil || !strings.Contains(err.Error(), `hold "h1" not found`) {
+		t.Errorf("got error %v, want not found", err)
+	}
+}
+
+func Test_ReleaseExpiredHolds(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ubld := sdk.NewCoins(sdk.NewInt64Coin("ubld", 500))
+	for _, hold := range []types.Hold{
+		{Id: "early", Owner: addr1, Coins: ubld, ExpiryTime: 100},
+		{Id: "late", Owner: addr1, Coins: ubld, ExpiryTime: 200},
+		{Id: "never", Owner: addr1, Coins: ubld},
+	} {
+		if err := keeper.SetHold(ctx, hold); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	released := keeper.ReleaseExpiredHolds(ctx.WithBlockTime(time.Unix(150, 0)))
+	if len(released) != 1 || released[0].Id != "early" {
+		t.Fatalf("got released %+v, want early", released)
+	}
+
+	// A failing release is retried with exponential backoff, then given up.
+	bank.sendToAccountErr = fmt.Errorf("blocked")
+	now := int64(200)
+	for attempt := uint32(1); attempt <= vbankkeeper.MaxHoldReleaseAttempts; attempt++ {
+		if released := keeper.ReleaseExpiredHolds(ctx.WithBlockTime(time.Unix(now, 0))); len(released) != 0 {
+			t.Fatalf("attempt %d: got released %+v", attempt, released)
+		}
+		hold, _, err := keeper.GetHold(ctx, "late")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hold.ReleaseAttempts != attempt {
+			t.Fatalf("got %d release attempts, want %d", hold.ReleaseAttempts, attempt)
+		}
+		if attempt == vbankkeeper.MaxHoldReleaseAttempts {
+			if hold.ExpiryTime != 0 {
+				t.Errorf("got expiry time %d after giving up, want 0", hold.ExpiryTime)
+			}
+			break
+		}
+		wantExpiry := now + vbankkeeper.HoldReleaseRetryDelay<<(attempt-1)
+		if hold.ExpiryTime != wantExpiry {
+			t.Fatalf("attempt %d: got expiry time %d, want %d", attempt, hold.ExpiryTime, wantExpiry)
+		}
+		// Not retried before the postponed expiry time.
+		if released := keeper.ReleaseExpiredHolds(ctx.WithBlockTime(time.Unix(wantExpiry-1, 0))); len(released) != 0 {
+			t.Fatalf("attempt %d: got released %+v early", attempt, released)
+		}
+		if hold, _, _ := keeper.GetHold(ctx, "late"); hold.ReleaseAttempts != attempt {
+			t.Fatalf("attempt %d: retried before expiry", attempt)
+		}
+		now = wantExpiry
+	}
+
+	bank.sendToAccountErr = nil
+	if released := keeper.ReleaseExpiredHolds(ctx.WithBlockTime(time.Unix(1<<40, 0))); len(released) != 0 {
+		t.Errorf("got released %+v after giving up", released)
+	}
+	if _, err := keeper.ReleaseHold(ctx, "late"); err != nil {
+		t.Errorf("got error releasing explicitly: %v", err)
+	}
+}
+
+func Test_HoldPoolGenesisInvariant(t *testing.T) {
+	holdPool := authtypes.NewModuleAddress(types.HoldPoolName).String()
+	ubld := sdk.NewCoins(sdk.NewInt64Coin("ubld", 500))
+	genesis := DefaultGenesisState()
+	genesis.Holds = []types.Hold{
+		{Id: "h1", Owner: addr1, Coins: ubld},
+		{Id: "h2", Owner: addr2, Coins: ubld},
+	}
+
+	bank := &mockBank{balances: map[string]sdk.Coins{holdPool: ubld.Add(ubld...)}}
+	keeper, ctx := makeTestKit(nil, bank)
+	InitGenesis(ctx, keeper, genesis)
+
+	bank = &mockBank{balances: map[string]sdk.Coins{holdPool: ubld}}
+	keeper, ctx = makeTestKit(nil, bank)
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "holds total 1000ubld") {
+			t.Errorf("got recover %v, want holds total mismatch", r)
+		}
+	}()
+	InitGenesis(ctx, keeper, genesis)
+}
+
+func Test_Receive_Watch(t *testing.T) {
+	keeper, ctx := makeTestKit(nil, nil)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	steps := []struct {
+		msgType string
+		denom   string
+		want    string
+		wantErr string
+	}{
+		{msgType: "VBANK_WATCH", denom: "ubld", want: "1"},
+		{msgType: "VBANK_WATCH", denom: "ubld", want: "2"},
+		{msgType: "VBANK_WATCH", denom: "*", want: "1"},
+		{msgType: "VBANK_WATCH", denom: "u", wantErr: "cannot watch: invalid denom u"},
+		{msgType: "VBANK_UNWATCH", denom: "ubld", want: "1"},
+		{msgType: "VBANK_UNWATCH", denom: "ubld", want: "0"},
+		{msgType: "VBANK_UNWATCH", denom: "ubld", wantErr: "cannot unwatch: " + addr1 + " is not watched in ubld"},
+	}
+	for i, step := range steps {
+		ret, err := ch.Receive(ctlCtx, `{"type": "`+step.msgType+`", "address": "`+addr1+`", "denom": "`+step.denom+`"}`)
+		if len(step.wantErr) > 0 {
+			if err == nil || !strings.HasPrefix(err.Error(), step.wantErr) {
+				t.Errorf("step %d: got error %v, want %q", i, err, step.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("step %d: got error = %v", i, err)
+		}
+		if ret != step.want {
+			t.Errorf("step %d: got %v, want %v", i, ret, step.want)
+		}
+	}
+
+	got := keeper.GetWatches(ctx, "")
+	want := []types.Watch{{Address: addr1, Denom: "*", Count: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got watches %+v, want %+v", got, want)
+	}
+}
+
+func Test_LegacyMonitoring(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{}}
+	keeper, ctx := makeTestKit(nil, bank)
+	ubld := sdk.NewCoins(sdk.NewInt64Coin("ubld", 1))
+
+	// send runs the send restriction, returning the addresses whose balance
+	// updates would be reported at the end of the block.
+	send := func(from, to string) []string {
+		t.Helper()
+		adStore := keeper.OpenAddressToUpdateStore(ctx)
+		var keys [][]byte
+		iterator := adStore.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			adStore.Delete(key)
+		}
+		if _, err := bank.restriction(ctx, sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(to), ubld); err != nil {
+			t.Fatalf("send restriction error = %v", err)
+		}
+		updates, err := keeper.WatchedBalanceUpdates(ctx)
+		if err != nil {
+			t.Fatalf("WatchedBalanceUpdates error = %v", err)
+		}
+		addresses := []string{}
+		for address := range updates {
+			addresses = append(addresses, address)
+		}
+		return addresses
+	}
+
+	params := types.DefaultParams()
+	params.AllowedMonitoringAccounts = []string{"*"}
+	keeper.SetParams(ctx, params)
+	if got := send(addr1, addr2); len(got) != 2 {
+		t.Errorf("got updates %v with \"*\", want both accounts", got)
+	}
+
+	// Registering a watch leaves the parameter alone.
+	if _, err := keeper.AddWatch(ctx, addr1, "ubld"); err != nil {
+		t.Fatal(err)
+	}
+	if got := keeper.GetParams(ctx).AllowedMonitoringAccounts; !reflect.DeepEqual(got, []string{"*"}) {
+		t.Errorf("got allowed monitoring accounts %v, want [*]", got)
+	}
+	if got := send(addr2, addr3); len(got) != 2 {
+		t.Errorf("got updates %v with \"*\" and a watch, want both accounts", got)
+	}
+
+	// Watches add to the accounts allowed by the parameter.
+	params.AllowedMonitoringAccounts = []string{addr3}
+	keeper.SetParams(ctx, params)
+	if got, want := send(addr2, addr3), []string{addr3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got updates %v, want %v", got, want)
+	}
+	if got, want := send(addr2, addr1), []string{addr1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got updates %v, want %v", got, want)
+	}
+
+	// Emptying the parameter stops the explicit monitoring too.
+	params.AllowedMonitoringAccounts = []string{}
+	keeper.SetParams(ctx, params)
+	if got := send(addr2, addr3); len(got) != 0 {
+		t.Errorf("got updates %v, want none", got)
+	}
+}
+
+func Test_RewardHistory(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{}}
+	keeper, ctx := makeTestKit(nil, bank)
+	am := NewAppModule(keeper)
+
+	urun := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("urun", amount))
+	}
+	half := sdkmath.LegacyNewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, types.Params{
+		RewardEpochDurationBlocks: 4,
+		RewardSmoothingBlocks:     2,
+		PerEpochRewardFraction:    half,
+		RewardHistoryEpochs:       2,
+	})
+	keeper.SetState(ctx, types.State{RewardPool: urun(1000)})
+
+	for height := int64(10); height <= 20; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		if err := am.EndBlock(ctx); err != nil {
+			t.Fatalf("EndBlock error = %v", err)
+		}
+	}
+
+	// The epoch that started at block 10 was pruned.
+	got, err := keeper.GetRewardHistory(ctx, 0)
+	if err != nil {
+		t.Fatalf("GetRewardHistory error = %v", err)
+	}
+	want := []types.RewardEpoch{
+		{StartBlock: 18, RewardPool: urun(562), PerEpochRewardFraction: half, EpochAmount: urun(281), Distributed: urun(141), LastDistributionBlock: 19},
+		{StartBlock: 14, RewardPool: urun(750), PerEpochRewardFraction: half, EpochAmount: urun(375), Distributed: urun(188), LastDistributionBlock: 15},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got history %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("got epoch %d %v, want %v", i, got[i], want[i])
+		}
+	}
+	if got, err := keeper.GetRewardHistory(ctx, 1); err != nil || len(got) != 1 || got[0].StartBlock != 18 {
+		t.Errorf("got limited history %v, err %v, want only the epoch starting at 18", got, err)
+	}
+
+	before, err := keeper.GetState(ctx)
+	if err != nil {
+		t.Fatalf("GetState error = %v", err)
+	}
+	total, pool, epochs, err := keeper.ProjectRewards(ctx, 8)
+	if err != nil {
+		t.Fatalf("ProjectRewards error = %v", err)
+	}
+	if !total.Equal(urun(184)) || !pool.Equal(urun(237)) {
+		t.Errorf("got projected total %v and pool %v, want 184urun and 237urun", total, pool)
+	}
+	wantEpochs := []types.RewardEpoch{
+		{StartBlock: 22, RewardPool: urun(421), PerEpochRewardFraction: half, EpochAmount: urun(210), Distributed: urun(105), LastDistributionBlock: 23},
+		{StartBlock: 26, RewardPool: urun(316), PerEpochRewardFraction: half, EpochAmount: urun(158), Distributed: urun(79), LastDistributionBlock: 27},
+	}
+	if len(epochs) != len(wantEpochs) {
+		t.Fatalf("got projected epochs %v, want %v", epochs, wantEpochs)
+	}
+	for i := range wantEpochs {
+		if !epochs[i].Equal(wantEpochs[i]) {
+			t.Errorf("got projected epoch %d %v, want %v", i, epochs[i], wantEpochs[i])
+		}
+	}
+	after, err := keeper.GetState(ctx)
+	if err != nil {
+		t.Fatalf("GetState error = %v", err)
+	}
+	if !after.Equal(before) {
+		t.Errorf("projection changed state from %v to %v", before, after)
+	}
+	if _, _, _, err := keeper.ProjectRewards(ctx, 0); err == nil {
+		t.Errorf("got no error projecting 0 blocks")
+	}
+}
+
+type mockDistributionKeeper struct {
+	calls []string
+}
+
+func (d *mockDistributionKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	d.calls = append(d.calls, fmt.Sprintf("FundCommunityPool %s %s", amount, sender))
+	return nil
+}
+
+func Test_RewardSplits(t *testing.T) {
+	reserveBech32 := authtypes.NewModuleAddress("vbank/reserve").String()
+	acct := &mockAuthKeeper{
+		accounts: map[string]authtypes.AccountI{
+			reserveBech32: authtypes.NewEmptyModuleAccount("vbank/reserve"),
+		},
+		modAddrs: map[string]string{
+			"vbank/reserve": reserveBech32,
+		},
+	}
+	bank := &mockBank{balances: map[string]sdk.Coins{}}
+	distr := &mockDistributionKeeper{}
+	keeper, ctx := makeTestKit(acct, bank)
+	keeper = keeper.WithDistributionKeeper(distr)
+
+	split := func(destination, weight string) types.RewardSplit {
+		return types.RewardSplit{Destination: destination, Weight: sdkmath.LegacyMustNewDecFromStr(weight)}
+	}
+	params := types.DefaultParams()
+	for _, destination := range []string{"missing", types.HoldPoolName, types.ProvisionPoolName} {
+		params.RewardSplits = []types.RewardSplit{split(destination, "1")}
+		if err := params.ValidateBasic(); err == nil || !strings.Contains(err.Error(), "is not one of") {
+			t.Errorf("got ValidateBasic error %v for destination %q, want not allowed", err, destination)
+		}
+	}
+	params.RewardSplits = []types.RewardSplit{
+		split("vbank/reserve", "0.5"),
+		split(types.RewardSplitCommunityPool, "0.3"),
+		split(types.GiveawayPoolName, "0.2"),
+	}
+	if err := params.ValidateBasic(); err != nil {
+		t.Fatalf("ValidateBasic error = %v", err)
+	}
+	keeper.SetParams(ctx, params)
+
+	amt := sdk.NewCoins(sdk.NewInt64Coin("urun", 1001), sdk.NewInt64Coin("ubld", 3))
+	bank.calls = []string{}
+	if err := keeper.SendCoinsToRewardDistributor(ctx, amt); err != nil {
+		t.Fatalf("SendCoinsToRewardDistributor error = %v", err)
+	}
+
+	// Rounding remainders go to the last destination.
+	wantCalls := []string{
+		"SendCoinsFromModuleToModule vbank vbank/reserve 1ubld,500urun",
+		"SendCoinsFromModuleToModule vbank vbank/giveaway 2ubld,201urun",
+	}
+	if !reflect.DeepEqual(bank.calls, wantCalls) {
+		t.Errorf("got bank calls %v, want %v", bank.calls, wantCalls)
+	}
+	wantDistrCalls := []string{
+		"FundCommunityPool 300urun " + authtypes.NewModuleAddress(types.ModuleName).String(),
+	}
+	if !reflect.DeepEqual(distr.calls, wantDistrCalls) {
+		t.Errorf("got distribution calls %v, want %v", distr.calls, wantDistrCalls)
+	}
+
+	var events []string
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeRewardSplit {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range ev.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		events = append(events, attrs[types.AttributeKeyDestination]+" "+attrs[sdk.AttributeKeyAmount])
+	}
+	wantEvents := []string{
+		"vbank/reserve 1ubld,500urun",
+		"community_pool 300urun",
+		"vbank/giveaway 2ubld,201urun",
+	}
+	if !reflect.DeepEqual(events, wantEvents) {
+		t.Errorf("got events %v, want %v", events, wantEvents)
+	}
+
+	for _, bad := range [][]types.RewardSplit{
+		{split("vbank/reserve", "0.5")},
+		{split("vbank/reserve", "0.5"), split("vbank/reserve", "0.5")},
+		{split("vbank/reserve", "1.5"), split("", "-0.5")},
+		{split("vbank/reserve", "1"), split("fee_collector", "0")},
+	} {
+		params.RewardSplits = bad
+		if err := params.ValidateBasic(); err == nil {
+			t.Errorf("got no error validating reward splits %v", bad)
+		}
+	}
+}
+
+func Test_Receive_DenomMetadata(t *testing.T) {
+	bank := &mockBank{
+		metadata: map[string]banktypes.Metadata{
+			"uist": {
+				Base:    "uist",
+				Display: "IST",
+				Symbol:  "IST",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: "uist", Exponent: 0},
+					{Denom: "IST", Exponent: 6},
+				},
+			},
+		},
+	}
+	keeper, ctx := makeTestKit(nil, bank)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+	bank.calls = nil
+
+	want := `{"base":"uist","display":"IST","exponent":6,"symbol":"IST","denomUnits":[{"denom":"uist","exponent":0},{"denom":"IST","exponent":6}]}`
+	for i := 0; i < 2; i++ {
+		ret, err := ch.Receive(ctlCtx, `{"type": "VBANK_GET_DENOM_METADATA", "denom": "uist"}`)
+		if err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+		if ret != want {
+			t.Errorf("got %v, want %v", ret, want)
+		}
+	}
+	ret, err := ch.Receive(ctlCtx, `{"type": "VBANK_GET_DENOM_METADATA", "denom": "ubld"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if ret != "null" {
+		t.Errorf("got %v, want null", ret)
+	}
+	_, err = ch.Receive(ctlCtx, `{"type": "VBANK_GET_DENOM_METADATA", "denom": "ubld"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+
+	// Each denom is looked up only once per block.
+	wantCalls := []string{"GetDenomMetaData uist", "GetDenomMetaData ubld"}
+	if !reflect.DeepEqual(bank.calls, wantCalls) {
+		t.Errorf("got calls %v, want %v", bank.calls, wantCalls)
+	}
+}
+
+type mockTransferKeeper struct {
+	denoms map[string]transfertypes.Denom
+	calls  int
+}
+
+func (tk *mockTransferKeeper) GetDenom(_ sdk.Context, denomHash cmtbytes.HexBytes) (transfertypes.Denom, bool) {
+	tk.calls++
+	denom, ok := tk.denoms[denomHash.String()]
+	return denom, ok
+}
+
+func Test_Receive_ResolveIBCDenom(t *testing.T) {
+	atom := transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-0"))
+	tk := &mockTransferKeeper{
+		denoms: map[string]transfertypes.Denom{atom.Hash().String(): atom},
+	}
+	keeper, ctx := makeTestKit(nil, nil)
+	keeper.SetTransferKeeper(tk)
+	ch := NewPortHandler(AppModule{}, keeper)
+	ctlCtx := sdk.WrapSDKContext(ctx)
+
+	want := `{"denom":"` + atom.IBCDenom() + `","baseDenom":"uatom","path":"transfer/channel-0/uatom","trace":[{"portId":"transfer","channelId":"channel-0"}]}`
+	for i := 0; i < 2; i++ {
+		ret, err := ch.Receive(ctlCtx, `{"type": "VBANK_RESOLVE_IBC_DENOM", "denom": "`+atom.IBCDenom()+`"}`)
+		if err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+		if ret != want {
+			t.Errorf("got %v, want %v", ret, want)
+		}
+	}
+	if tk.calls != 1 {
+		t.Errorf("got %d transfer keeper calls, want 1", tk.calls)
+	}
+
+	ret, err := ch.Receive(ctlCtx, `{"type": "VBANK_RESOLVE_IBC_DENOM", "denom": "ubld"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if want := `{"denom":"ubld","baseDenom":"ubld","path":"ubld","trace":[]}`; ret != want {
+		t.Errorf("got %v, want %v", ret, want)
+	}
+
+	unknown := transfertypes.NewDenom("uosmo", transfertypes.NewHop("transfer", "channel-1")).IBCDenom()
+	_, err = ch.Receive(ctlCtx, `{"type": "VBANK_RESOLVE_IBC_DENOM", "denom": "`+unknown+`"}`)
+	if err == nil || err.Error() != "unknown IBC denom "+unknown {
+		t.Errorf("got error %v, want unknown IBC denom", err)
+	}
+	_, err = ch.Receive(ctlCtx, `{"type": "VBANK_RESOLVE_IBC_DENOM", "denom": "ibc/xyz"}`)
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid IBC denom ibc/xyz") {
+		t.Errorf("got error %v, want invalid IBC denom", err)
+	}
+}
+
+func Test_BalanceSnapshots(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{
+		addr1: sdk.NewCoins(sdk.NewInt64Coin("ubld", 100)),
+	}}
+	keeper, ctx := makeTestKit(nil, bank)
+	params := types.DefaultParams()
+	params.SnapshotIntervalBlocks = 10
+	keeper.SetParams(ctx, params)
+	ch := NewPortHandler(AppModule{}, keeper)
+
+	ret, err := ch.Receive(sdk.WrapSDKContext(ctx), `{"type": "VBANK_REGISTER_SNAPSHOTS", "address": "`+addr1+`"}`)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if ret != "true" {
+		t.Errorf("got %v, want true", ret)
+	}
+
+	// Snapshots are taken only at multiples of the interval, and only when the
+	// balances have changed.
+	for _, step := range []struct {
+		height   int64
+		balances sdk.Coins
+	}{
+		{10, sdk.NewCoins(sdk.NewInt64Coin("ubld", 100))},
+		{20, sdk.NewCoins(sdk.NewInt64Coin("ubld", 100))},
+		{25, sdk.NewCoins(sdk.NewInt64Coin("ubld", 50))},
+		{30, sdk.NewCoins(sdk.NewInt64Coin("ubld", 50), sdk.NewInt64Coin("urun", 7))},
+	} {
+		bank.balances[addr1] = step.balances
+		if err := keeper.TakeBalanceSnapshots(ctx.WithBlockHeight(step.height)); err != nil {
+			t.Fatalf("height %d: got error = %v", step.height, err)
+		}
+	}
+	snapshots, err := keeper.GetAllBalanceSnapshots(ctx)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if len(snapshots) != 2 {
+		t.Errorf("got snapshots %+v, want 2", snapshots)
+	}
+
+	ctlCtx := sdk.WrapSDKContext(ctx.WithBlockHeight(35))
+	for _, step := range []struct {
+		height  string
+		want    string
+		wantErr string
+	}{
+		{height: "9", wantErr: "cannot get balances: no snapshot of " + addr1 + " at or before height 9"},
+		{height: "10", want: `{"address":"` + addr1 + `","height":10,"balances":[{"denom":"ubld","amount":"100"}]}`},
+		{height: "29", want: `{"address":"` + addr1 + `","height":10,"balances":[{"denom":"ubld","amount":"100"}]}`},
+		{height: "30", want: `{"address":"` + addr1 + `","height":30,"balances":[{"denom":"ubld","amount":"50"},{"denom":"urun","amount":"7"}]}`},
+		{height: "35", wantErr: "cannot get balances: block 35 has not ended"},
+		{height: "36", wantErr: "cannot get balances: block 36 has not ended"},
+	} {
+		ret, err := ch.Receive(ctlCtx, `{"type": "VBANK_GET_BALANCES_AT", "address": "`+addr1+`", "height": `+step.height+`}`)
+		if len(step.wantErr) > 0 {
+			if err == nil || err.Error() != step.wantErr {
+				t.Errorf("height %s: got error %v, want %q", step.height, err, step.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("height %s: got error = %v", step.height, err)
+		}
+		if ret != step.want {
+			t.Errorf("height %s: got %v, want %v", step.height, ret, step.want)
+		}
+	}
+
+	if _, err := ch.Receive(ctlCtx, `{"type": "VBANK_UNREGISTER_SNAPSHOTS", "address": "`+addr1+`"}`); err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	_, err = ch.Receive(ctlCtx, `{"type": "VBANK_GET_BALANCES_AT", "address": "`+addr1+`", "height": 30}`)
+	if want := "cannot get balances: " + addr1 + " is not registered for snapshots"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+	if snapshots, _ := keeper.GetAllBalanceSnapshots(ctx); len(snapshots) != 0 {
+		t.Errorf("got snapshots %+v after unregistering, want none", snapshots)
+	}
+}
+
+func Test_BalanceSnapshotLimits(t *testing.T) {
+	bank := &mockBank{balances: map[string]sdk.Coins{}}
+	keeper, ctx := makeTestKit(nil, bank)
+	params := types.DefaultParams()
+	params.SnapshotIntervalBlocks = 10
+	params.MaxSnapshotAddresses = 2
+	params.SnapshotRetentionBlocks = 25
+	keeper.SetParams(ctx, params)
+
+	for _, address := range []string{addr1, addr2} {
+		if err := keeper.RegisterSnapshots(ctx, address); err != nil {
+			t.Fatalf("got error = %v", err)
+		}
+	}
+	// Registering again is not counted.
+	if err := keeper.RegisterSnapshots(ctx, addr1); err != nil {
+		t.Errorf("got error = %v re-registering", err)
+	}
+	err := keeper.RegisterSnapshots(ctx, addr3)
+	if want := "2 addresses are already registered for snapshots, the maximum"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+
+	// The snapshot of addr1 at 20 gives its balances at the start of the range
+	// retained at 50, so only the one at 10 is pruned.
+	for height := int64(10); height <= 50; height += 10 {
+		bank.balances[addr1] = sdk.NewCoins(sdk.NewInt64Coin("ubld", height))
+		if err := keeper.TakeBalanceSnapshots(ctx.WithBlockHeight(height)); err != nil {
+			t.Fatalf("height %d: got error = %v", height, err)
+		}
+	}
+	snapshots, err := keeper.GetAllBalanceSnapshots(ctx)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	heights := map[string][]int64{}
+	for _, snapshot := range snapshots {
+		heights[snapshot.Address] = append(heights[snapshot.Address], snapshot.Height)
+	}
+	// The unchanged balances of addr2 are still given by its first snapshot.
+	want := map[string][]int64{addr1: {20, 30, 40, 50}, addr2: {10}}
+	if !reflect.DeepEqual(heights, want) {
+		t.Errorf("got snapshot heights %v, want %v", heights, want)
+	}
+
+	queryCtx := ctx.WithBlockHeight(50)
+	snapshot, err := keeper.GetBalancesAt(queryCtx, addr1, 26)
+	if err != nil {
+		t.Fatalf("got error = %v", err)
+	}
+	if snapshot.Height != 20 {
+		t.Errorf("got snapshot at %d, want 20", snapshot.Height)
+	}
+	_, err = keeper.GetBalancesAt(queryCtx, addr1, 25)
+	if want := "height 25 is before the retained snapshots, which start at 26"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	vibcQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vibc module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vibcQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryInFlightPackets(),
+		GetCmdQueryBoundPorts(),
+		GetCmdQueryOverdueAcks(),
+		GetCmdQueryChannelDiagnostics(),
+	)
+
+	return vibcQueryCmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query vibc params",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryInFlightPackets implements the query in-flight-packets command.
+func GetCmdQueryInFlightPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "in-flight-packets [port-id] [channel-id]",
+		Args:  cobra.MaximumNArgs(2),
+		Short: "Query the packets sent by the VM that await acknowledgement or timeout, optionally of one port or channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryInFlightPacketsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.PortId = args[0]
+			}
+			if len(args) > 1 {
+				req.ChannelId = args[1]
+			}
+			res, err := queryClient.InFlightPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight-packets")
+	return cmd
+}
+
+// GetCmdQueryBoundPorts implements the query bound-ports command.
+func GetCmdQueryBoundPorts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bound-ports",
+		Args:  cobra.NoArgs,
+		Short: "Query the ports dynamically bound by the VM",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.BoundPorts(cmd.Context(), &types.QueryBoundPortsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bound-ports")
+	return cmd
+}
+
+// GetCmdQueryOverdueAcks implements the query overdue-acks command.
+func GetCmdQueryOverdueAcks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "overdue-acks",
+		Args:  cobra.NoArgs,
+		Short: "Query the packets received by the VM that are past their acknowledgement deadline",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OverdueAcks(cmd.Context(), &types.QueryOverdueAcksRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryChannelDiagnostics implements the query channel-diagnostics
+// command.
+func GetCmdQueryChannelDiagnostics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-diagnostics [port-id] [channel-id]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the sequences of the channels of a VM-owned port against the packets the VM has yet to settle",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelDiagnosticsRequest{PortId: args[0]}
+			if len(args) > 1 {
+				req.ChannelId = args[1]
+			}
+			res, err := queryClient.ChannelDiagnostics(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -31,6 +31,10 @@ type sendPacketAction struct {
 	*MsgSendPacket
 }
 
+func init() {
+	vm.RegisterAction(&sendPacketAction{})
+}
+
 func handleMsgSendPacket(
 	ctx sdk.Context,
 	keeper Keeper,
@@ -0,0 +1,171 @@
+package vibc_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	app "github.com/Agoric/agoric-sdk/golang/cosmos/app"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	swingsettesting "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/testing"
+	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// newAgoricChain returns an ibctesting chain running the Agoric app, whose VM
+// accepts every action without acting on it.
+func newAgoricChain(t *testing.T, coordinator *ibctesting.Coordinator, index int) *ibctesting.TestChain {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		controller := func(ctx context.Context, needReply bool, jsonRequest string) (string, error) {
+			return "true", nil
+		}
+		appd := app.NewAgoricApp(controller, vm.NewAgdServer(), log.NewTestLogger(t), dbm.NewMemDB(), nil,
+			true, sims.EmptyAppOptions{})
+		return appd, app.NewDefaultGenesisState(appd.AppCodec(), appd.BasicModuleManager)
+	}
+
+	chainID := ibctesting.GetChainID(index)
+	chain := ibctesting.NewTestChain(t, coordinator, chainID)
+	balance := banktypes.Balance{
+		Address: chain.SenderAccount.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000000000000))),
+	}
+	chain.App = ibctesting.SetupWithGenesisValSet(t, chain.Vals,
+		[]authtypes.GenesisAccount{chain.SenderAccount.(authtypes.GenesisAccount)},
+		chainID, sdk.DefaultPowerReduction, balance)
+	chain.TxConfig = chain.App.GetTxConfig()
+	chain.Codec = chain.App.AppCodec()
+	chain.ProposedHeader.Height = 1
+	chain.ProposedHeader.Time = coordinator.CurrentTime.UTC()
+	coordinator.CommitBlock(chain)
+	return chain
+}
+
+// callVibc sends a message from the VM to the vibc port of chain, returning
+// the events it emitted.
+func callVibc(t *testing.T, chain *ibctesting.TestChain, method string, fields map[string]any) sdk.Events {
+	t.Helper()
+	agdServer := chain.App.(*app.GaiaApp).AgdServer
+	ctx := chain.GetContext()
+	defer agdServer.SetControllerContext(ctx)()
+
+	msg := map[string]any{"type": "IBC_METHOD", "method": method}
+	for k, v := range fields {
+		msg[k] = v
+	}
+	bz, err := json.Marshal(msg)
+	require.NoError(t, err)
+	var reply string
+	err = agdServer.ReceiveMessage(&vm.Message{Port: agdServer.GetPort("vibc"), Data: string(bz)}, &reply)
+	require.NoError(t, err)
+	return ctx.EventManager().Events()
+}
+
+// requireQueuedEvent checks that the VM was sent an IBC event with
+// asyncVersions, and clears its action queue.
+func requireQueuedEvent(t *testing.T, chain *ibctesting.TestChain, event string) {
+	t.Helper()
+	swingsetKeeper := chain.App.(*app.GaiaApp).SwingSetKeeper
+	records, err := swingsettesting.GetActionQueueRecords(t, chain.GetContext(), swingsetKeeper)
+	require.NoError(t, err)
+	found := false
+	for _, record := range records {
+		if strings.Contains(record, `"event":"`+event+`"`) {
+			require.Contains(t, record, `"asyncVersions":true`)
+			found = true
+		}
+	}
+	require.True(t, found, "no %s event in %v", event, records)
+	require.NoError(t, swingsettesting.ResetActionQueue(t, chain.GetContext(), swingsetKeeper))
+}
+
+func TestAsyncVersionHandshake(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 0)
+	chainA := newAgoricChain(t, coordinator, 0)
+	chainB := newAgoricChain(t, coordinator, 1)
+	coordinator.Chains = map[string]*ibctesting.TestChain{
+		chainA.ChainID: chainA,
+		chainB.ChainID: chainB,
+	}
+
+	const version = "v1"
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = "port-a"
+	path.EndpointB.ChannelConfig.PortID = "port-b"
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	path.SetupConnections()
+	a, b := path.EndpointA, path.EndpointB
+
+	// ChanOpenInit leaves the version to the VM, which writes it without
+	// rewriting the rest of the channel.
+	require.NoError(t, a.ChanOpenInit())
+	require.Equal(t, "", a.GetChannel().Version)
+	requireQueuedEvent(t, chainA, "channelOpenInit")
+	events := callVibc(t, chainA, "initOpenExecuted", map[string]any{
+		"packet": map[string]string{
+			"source_port": a.ChannelConfig.PortID, "source_channel": a.ChannelID,
+			"destination_port": b.ChannelConfig.PortID,
+		},
+		"order":   "UNORDERED",
+		"hops":    []string{a.ConnectionID},
+		"version": version,
+	})
+	require.Len(t, events, 1)
+	require.Equal(t, vibctypes.EventTypeChannelVersionWritten, events[0].Type)
+	require.Equal(t, channeltypes.INIT, a.GetChannel().State)
+	require.Equal(t, version, a.GetChannel().Version)
+	coordinator.CommitBlock(chainA)
+	a.ChannelConfig.Version, b.ChannelConfig.Version = version, version
+
+	// ChanOpenTry proves the version written by the VM of chainA, and leaves
+	// its own to the VM of chainB.
+	require.NoError(t, b.ChanOpenTry())
+	require.Equal(t, "", b.GetChannel().Version)
+	requireQueuedEvent(t, chainB, "channelOpenTry")
+	callVibc(t, chainB, "tryOpenExecuted", map[string]any{
+		"packet": map[string]string{
+			"source_port": a.ChannelConfig.PortID, "source_channel": a.ChannelID,
+			"destination_port": b.ChannelConfig.PortID, "destination_channel": b.ChannelID,
+		},
+		"order":   "UNORDERED",
+		"hops":    []string{b.ConnectionID},
+		"version": version,
+	})
+	require.Equal(t, channeltypes.TRYOPEN, b.GetChannel().State)
+	require.Equal(t, version, b.GetChannel().Version)
+	coordinator.CommitBlock(chainB)
+	a.ChannelConfig.Version, b.ChannelConfig.Version = version, version
+
+	// ChanOpenAck opens the channel on chainA, where the VM accepts the
+	// counterparty version.
+	require.NoError(t, a.ChanOpenAck())
+	requireQueuedEvent(t, chainA, "channelOpenAck")
+	callVibc(t, chainA, "ackOpenExecuted", map[string]any{
+		"packet": map[string]string{"source_port": a.ChannelConfig.PortID, "source_channel": a.ChannelID},
+	})
+	coordinator.CommitBlock(chainA)
+
+	require.NoError(t, b.ChanOpenConfirm())
+	for _, endpoint := range []*ibctesting.Endpoint{a, b} {
+		channel := endpoint.GetChannel()
+		require.Equal(t, channeltypes.OPEN, channel.State)
+		require.Equal(t, version, channel.Version)
+		seq, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+		require.True(t, found)
+		require.Equal(t, uint64(1), seq)
+	}
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	agtypes "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// pendingAckStoreKeyPrefix maps the "<port>/<channel>/<sequence>" of each
+// packet received by the VM to its PendingAck, until it is acknowledged.
+// ackDeadlineStoreKeyPrefix indexes the same by the big-endian deadline height
+// followed by the packet key, so that overdue acks come first.
+const (
+	paramsStoreKey            = "params"
+	pendingAckStoreKeyPrefix  = "pendingAck-"
+	ackDeadlineStoreKeyPrefix = "ackDeadline-"
+)
+
+// maxAckExpirationsPerBlock bounds the work of EndBlock.  Further overdue acks
+// are expired in the following blocks.
+const maxAckExpirationsPerBlock = 100
+
+func (k Keeper) openStore(ctx sdk.Context) (storetypes.KVStore, bool) {
+	if k.storeService == nil {
+		return nil, false
+	}
+	return runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), true
+}
+
+func ackDeadlineKey(deadline int64, key []byte) []byte {
+	return append(binary.BigEndian.AppendUint64(nil, uint64(deadline)), key...)
+}
+
+// GetParams returns the vibc params, which are the defaults if none were set.
+func (k Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
+	params := types.DefaultParams()
+	store, ok := k.openStore(ctx)
+	if !ok {
+		return params, nil
+	}
+	bz := store.Get([]byte(paramsStoreKey))
+	if bz == nil {
+		return params, nil
+	}
+	err := k.cdc.Unmarshal(bz, &params)
+	return params, err
+}
+
+// SetParams sets the vibc params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	store, ok := k.openStore(ctx)
+	if !ok {
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set([]byte(paramsStoreKey), bz)
+	return nil
+}
+
+// TrackPendingAck records a packet received asynchronously by the VM (or by
+// target, if nonempty), with the deadline of the current params.  ack, if not
+// nil, is the acknowledgement to write should the deadline pass.
+func (k Keeper) TrackPendingAck(ctx sdk.Context, target string, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	store, ok := k.openStore(ctx)
+	if !ok {
+		return nil
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	pending := types.PendingAck{
+		Packet:         types.NewPacket(packet),
+		Target:         target,
+		ReceiveHeight:  ctx.BlockHeight(),
+		DeadlineHeight: params.AckDeadlineHeight(ctx.BlockHeight()),
+	}
+	if ack != nil {
+		pending.Ack = ack.Acknowledgement()
+		pending.AckSuccess = ack.Success()
+	}
+	bz, err := k.cdc.Marshal(&pending)
+	if err != nil {
+		return err
+	}
+	key := packetKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	prefix.NewStore(store, []byte(pendingAckStoreKeyPrefix)).Set(key, bz)
+	if pending.DeadlineHeight > 0 {
+		prefix.NewStore(store, []byte(ackDeadlineStoreKeyPrefix)).Set(ackDeadlineKey(pending.DeadlineHeight, key), []byte{1})
+	}
+	return nil
+}
+
+// untrackPendingAck forgets a packet that has been acknowledged.
+func (k Keeper) untrackPendingAck(ctx sdk.Context, packet ibcexported.PacketI) error {
+	store, ok := k.openStore(ctx)
+	if !ok {
+		return nil
+	}
+	pendingStore := prefix.NewStore(store, []byte(pendingAckStoreKeyPrefix))
+	key := packetKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	bz := pendingStore.Get(key)
+	if bz == nil {
+		return nil
+	}
+	var pending types.PendingAck
+	if err := k.cdc.Unmarshal(bz, &pending); err != nil {
+		return err
+	}
+	pendingStore.Delete(key)
+	if pending.DeadlineHeight > 0 {
+		prefix.NewStore(store, []byte(ackDeadlineStoreKeyPrefix)).Delete(ackDeadlineKey(pending.DeadlineHeight, key))
+	}
+	return nil
+}
+
+// GetOverdueAcks returns up to limit (or all, if limit is zero) of the
+// pending acks whose deadline is at or before the current height, ordered by
+// deadline.
+func (k Keeper) GetOverdueAcks(ctx sdk.Context, limit int) ([]types.PendingAck, error) {
+	acks := []types.PendingAck{}
+	store, ok := k.openStore(ctx)
+	if !ok {
+		return acks, nil
+	}
+	pendingStore := prefix.NewStore(store, []byte(pendingAckStoreKeyPrefix))
+	deadlineStore := prefix.NewStore(store, []byte(ackDeadlineStoreKeyPrefix))
+	end := binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockHeight()+1))
+	iterator := deadlineStore.Iterator(nil, end)
+	defer iterator.Close()
+	for ; iterator.Valid() && (limit == 0 || len(acks) < limit); iterator.Next() {
+		var pending types.PendingAck
+		if err := k.cdc.Unmarshal(pendingStore.Get(iterator.Key()[8:]), &pending); err != nil {
+			return nil, err
+		}
+		acks = append(acks, pending)
+	}
+	return acks, nil
+}
+
+// ExpireOverdueAcks writes an acknowledgement for each packet that the VM has
+// not acknowledged by its deadline, up to maxAckExpirationsPerBlock.  That is
+// the acknowledgement recorded with the packet, if any.  Otherwise it is an
+// error, but only for a packet received by a VM-owned port: a packet with a
+// target was received by another module, whose effects an error would not
+// undo, so it is only forgotten, leaving its acknowledgement to the VM.
+func (k Keeper) ExpireOverdueAcks(ctx sdk.Context) error {
+	overdue, err := k.GetOverdueAcks(ctx, maxAckExpirationsPerBlock)
+	if err != nil {
+		return err
+	}
+	errAck := channeltypes.NewErrorAcknowledgement(types.ErrAckDeadlineExceeded)
+	for _, pending := range overdue {
+		if err := k.untrackPendingAck(ctx, pending.Packet); err != nil {
+			return err
+		}
+		var ack ibcexported.Acknowledgement
+		switch {
+		case len(pending.Ack) > 0:
+			ack = types.NewStoredAcknowledgement(pending.Ack, pending.AckSuccess)
+		case len(pending.Target) == 0:
+			ack = errAck
+		default:
+			ctx.Logger().Error("cannot expire acknowledgement of a packet received by another module",
+				"port-id", pending.Packet.DestinationPort,
+				"channel-id", pending.Packet.DestinationChannel,
+				"sequence", pending.Packet.Sequence,
+				"target", pending.Target)
+			continue
+		}
+		channelPacket := agtypes.CopyToChannelPacket(pending.Packet)
+		if err := k.channelKeeper.WriteAcknowledgement(ctx, channelPacket, ack); err != nil {
+			// The channel may have closed meanwhile, so there is no one to tell.
+			ctx.Logger().Error("cannot write overdue acknowledgement",
+				"port-id", pending.Packet.DestinationPort,
+				"channel-id", pending.Packet.DestinationChannel,
+				"sequence", pending.Packet.Sequence,
+				"err", err)
+			continue
+		}
+		ctx.EventManager().EmitEvent(types.NewAckExpiredEvent(pending))
+	}
+	return nil
+}
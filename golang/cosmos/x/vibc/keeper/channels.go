@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// OwnsPort returns whether the dynamic port scope routes portID to the VM.
+func (k Keeper) OwnsPort(portID string) bool {
+	return k.scope != nil && k.scope.OwnsPort(portID)
+}
+
+// countChannelPackets returns how many packets of the channel store holds,
+// and the sequence of the first of them.
+func countChannelPackets(store storetypes.KVStore, portID, channelID string) (count, oldest uint64) {
+	iterator := prefix.NewStore(store, channelPrefix(portID, channelID)).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if count == 0 {
+			oldest = sdk.BigEndianToUint64(iterator.Key())
+		}
+		count++
+	}
+	return count, oldest
+}
+
+// GetChannelDiagnostics returns a ChannelDiagnostic for each channel of the
+// VM-owned portID, or only for channelID, if given.  The port is required,
+// since the channels of all ports are not indexed for pagination.
+func (k Keeper) GetChannelDiagnostics(ctx sdk.Context, portID, channelID string) ([]types.ChannelDiagnostic, error) {
+	if len(portID) == 0 {
+		return nil, fmt.Errorf("port is required")
+	}
+	if err := types.ValidatePacketFilter(portID, channelID); err != nil {
+		return nil, err
+	}
+
+	var channels []channeltypes.IdentifiedChannel
+	if len(channelID) > 0 {
+		channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+		if !found {
+			return nil, fmt.Errorf("channel %s on port %s not found", channelID, portID)
+		}
+		channels = append(channels, channeltypes.NewIdentifiedChannel(portID, channelID, channel))
+	} else {
+		channels = k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
+	}
+
+	diagnostics := []types.ChannelDiagnostic{}
+	store, hasStore := k.openStore(ctx)
+	for _, channel := range channels {
+		// The port prefix also matches longer ports, so require an exact match.
+		if channel.PortId != portID {
+			continue
+		}
+		if !k.OwnsPort(channel.PortId) {
+			continue
+		}
+		diagnostic := types.ChannelDiagnostic{
+			PortId:    channel.PortId,
+			ChannelId: channel.ChannelId,
+			State:     channel.State.String(),
+			Ordering:  channel.Ordering.String(),
+		}
+		diagnostic.NextSequenceSend, _ = k.channelKeeper.GetNextSequenceSend(ctx, channel.PortId, channel.ChannelId)
+		diagnostic.NextSequenceRecv, _ = k.channelKeeper.GetNextSequenceRecv(ctx, channel.PortId, channel.ChannelId)
+		diagnostic.NextSequenceAck, _ = k.channelKeeper.GetNextSequenceAck(ctx, channel.PortId, channel.ChannelId)
+		if hasStore {
+			diagnostic.InFlightPackets, diagnostic.OldestInFlightSequence = countChannelPackets(
+				prefix.NewStore(store, []byte(inFlightPacketStoreKeyPrefix)), channel.PortId, channel.ChannelId)
+			diagnostic.PendingAcks, diagnostic.OldestPendingAckSequence = countChannelPackets(
+				prefix.NewStore(store, []byte(pendingAckStoreKeyPrefix)), channel.PortId, channel.ChannelId)
+		}
+		diagnostics = append(diagnostics, diagnostic)
+	}
+	return diagnostics, nil
+}
+
+// abandonPendingAcks forgets the acknowledgements that the VM owes on a
+// channel, and returns how many there were.
+func (k Keeper) abandonPendingAcks(ctx sdk.Context, portID, channelID string) (uint64, error) {
+	store, ok := k.openStore(ctx)
+	if !ok {
+		return 0, nil
+	}
+	var pendings []types.PendingAck
+	iterator := prefix.NewStore(store, []byte(pendingAckStoreKeyPrefix)).Iterator(
+		channelPrefix(portID, channelID), storetypes.PrefixEndBytes(channelPrefix(portID, channelID)))
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingAck
+		if err := k.cdc.Unmarshal(iterator.Value(), &pending); err != nil {
+			iterator.Close()
+			return 0, err
+		}
+		pendings = append(pendings, pending)
+	}
+	iterator.Close()
+
+	for _, pending := range pendings {
+		if err := k.untrackPendingAck(ctx, pending.Packet); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(pendings)), nil
+}
+
+// ForceCloseChannel closes an ordered channel of a VM-owned port, such as one
+// jammed by a packet that can be neither acknowledged nor timed out, and tells
+// the VM as if it had begun the closing itself.  The acknowledgements owed by
+// the VM on the channel can no longer be written, so they are abandoned.  The
+// packets in flight remain tracked until they time out on close.
+func (k Keeper) ForceCloseChannel(ctx sdk.Context, portID, channelID string) error {
+	if !k.OwnsPort(portID) {
+		return fmt.Errorf("port %s is not owned by the VM", portID)
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return fmt.Errorf("channel %s on port %s not found", channelID, portID)
+	}
+	if channel.Ordering != channeltypes.ORDERED {
+		return fmt.Errorf("channel %s on port %s is %s, not ORDERED", channelID, portID, channel.Ordering)
+	}
+
+	if err := k.channelKeeper.ChanCloseInit(ctx, portID, channelID); err != nil {
+		return err
+	}
+	abandoned, err := k.abandonPendingAcks(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	event := types.ChannelCloseInitEvent{
+		PortID:    portID,
+		ChannelID: channelID,
+	}
+	if err := k.PushAction(ctx, event); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(types.NewChannelForceClosedEvent(portID, channelID, abandoned))
+	return nil
+}
@@ -127,6 +127,19 @@ func (r *DynamicPortRouter) HasRoute(portID string) bool {
 	return r.base.HasRoute(portID)
 }
 
+// HasDynamicRoute returns whether portID is routed by a runtime binding or a
+// legacy prefix route rather than by the static router.
+func (r *DynamicPortRouter) HasDynamicRoute(portID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.dynamic[portID]; ok {
+		return true
+	}
+	_, ok := r.matchLegacyPrefixLocked(portID)
+	return ok
+}
+
 func (r *DynamicPortRouter) Keys() []string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
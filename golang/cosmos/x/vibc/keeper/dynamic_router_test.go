@@ -230,7 +230,7 @@ func TestKeeperReceiveBindPortUsesDynamicScope(t *testing.T) {
 	scope := NewDynamicPortScope(storeService, router, func(sdk.Context, vm.Action) error { return nil })
 	scope.SetDynamicModule(module)
 
-	keeper := NewKeeper(nil, nil, nil).WithScope(scope)
+	keeper := NewKeeper(nil, nil, nil, nil).WithScope(scope)
 	require.NoError(t, keeper.ReceiveBindPort(ctx, "port-1"))
 	require.True(t, router.HasRoute("port-1"))
 
@@ -238,3 +238,39 @@ func TestKeeperReceiveBindPortUsesDynamicScope(t *testing.T) {
 	require.NoError(t, keeper.RevokePort(ctx, "port-1"))
 	require.False(t, router.HasRoute("port-1"))
 }
+
+func TestBoundPortsQueryAndEvents(t *testing.T) {
+	ctx := makeTestContext(t).WithBlockHeight(5)
+	storeService := runtime.NewKVStoreService(vibcStoreKey)
+	router := NewDynamicPortRouter(porttypes.NewRouter())
+	scope := NewDynamicPortScope(storeService, router, func(sdk.Context, vm.Action) error { return nil })
+	scope.SetDynamicModule(&noopIBCModule{name: "dynamic"})
+	keeper := NewKeeper(nil, nil, nil, nil).WithScope(scope)
+
+	receiver := vibc.NewReceiver(keeper)
+	_, err := receiver.Receive(sdk.WrapSDKContext(ctx), `{"type":"IBC_METHOD","method":"bindPort","packet":{"source_port":"icq-1"}}`)
+	require.NoError(t, err)
+	require.NoError(t, keeper.BindPort(ctx, "port-2"))
+
+	// A binding that predates bind heights has none.
+	scope.boundPortStore(ctx).Set([]byte("port-1"), []byte{1})
+
+	res, err := keeper.BoundPorts(ctx, &vibc.QueryBoundPortsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []vibc.BoundPort{
+		{PortId: "icq-1", BindHeight: 5},
+		{PortId: "port-1"},
+		{PortId: "port-2", BindHeight: 5},
+	}, res.Ports)
+
+	_, err = receiver.Receive(sdk.WrapSDKContext(ctx), `{"type":"IBC_METHOD","method":"revokePort","packet":{"source_port":"icq-1"}}`)
+	require.NoError(t, err)
+	require.False(t, router.HasRoute("icq-1"))
+	require.ErrorContains(t, keeper.RevokePort(ctx, "icq-1"), "not dynamically bound")
+
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Equal(t, []string{vibc.EventTypePortBound, vibc.EventTypePortBound, vibc.EventTypePortRevoked}, eventTypes)
+}
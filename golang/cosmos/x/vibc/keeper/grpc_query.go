@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InFlightPackets queries the packets sent on behalf of the VM that await
+// acknowledgement or timeout
+func (k Keeper) InFlightPackets(c context.Context, req *types.QueryInFlightPacketsRequest) (*types.QueryInFlightPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidatePacketFilter(req.PortId, req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store, ok := k.openInFlightPacketStore(ctx, req.PortId, req.ChannelId)
+	if !ok {
+		return nil, status.Error(codes.Unavailable, "packet tracking is not configured")
+	}
+
+	packets := []types.InFlightPacket{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var inFlight types.InFlightPacket
+		if err := k.cdc.Unmarshal(value, &inFlight); err != nil {
+			return err
+		}
+		packets = append(packets, inFlight)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInFlightPacketsResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
+// BoundPorts queries the ports dynamically bound by the VM
+func (k Keeper) BoundPorts(c context.Context, req *types.QueryBoundPortsRequest) (*types.QueryBoundPortsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if k.scope == nil || k.scope.storeService == nil {
+		return nil, status.Error(codes.Unavailable, "dynamic port store is not configured")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ports := []types.BoundPort{}
+	pageRes, err := query.Paginate(k.scope.boundPortStore(ctx), req.Pagination, func(key, value []byte) error {
+		binding, err := types.UnmarshalBoundPort(string(key), value)
+		if err != nil {
+			return err
+		}
+		ports = append(ports, binding)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBoundPortsResponse{Ports: ports, Pagination: pageRes}, nil
+}
+
+// Params queries params of the vibc module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// OverdueAcks queries the packets received by the VM whose acknowledgement
+// deadline has passed
+func (k Keeper) OverdueAcks(c context.Context, req *types.QueryOverdueAcksRequest) (*types.QueryOverdueAcksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	acks, err := k.GetOverdueAcks(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOverdueAcksResponse{Acks: acks}, nil
+}
+
+// ChannelDiagnostics queries the sequences of the channels of a VM-owned port
+// against the packets that the VM has yet to settle
+func (k Keeper) ChannelDiagnostics(c context.Context, req *types.QueryChannelDiagnosticsRequest) (*types.QueryChannelDiagnosticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	channels, err := k.GetChannelDiagnostics(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryChannelDiagnosticsResponse{Channels: channels}, nil
+}
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+	"slices"
+
+	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -22,24 +26,31 @@ var (
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	cdc codec.Codec
+	cdc          codec.Codec
+	storeService corestore.KVStoreService
 
 	channelKeeper types.ChannelKeeper
 	clientKeeper  types.ClientKeeper
 
 	// Filled out by `WithScope`
 	scope *DynamicPortScope
+
+	// Filled out by `WithAuthority`
+	authority string
 }
 
-// NewKeeper creates a new vibc Keeper instance
+// NewKeeper creates a new vibc Keeper instance.  Packets sent by the VM are
+// tracked in the store of storeService, unless it is nil.
 func NewKeeper(
 	cdc codec.Codec,
+	storeService corestore.KVStoreService,
 	channelKeeper types.ChannelKeeper,
 	clientKeeper types.ClientKeeper,
 ) Keeper {
 
 	return Keeper{
 		cdc:           cdc,
+		storeService:  storeService,
 		channelKeeper: channelKeeper,
 		clientKeeper:  clientKeeper,
 	}
@@ -52,6 +63,13 @@ func (k Keeper) WithScope(scope *DynamicPortScope) Keeper {
 	return k
 }
 
+// WithAuthority returns a new Keeper copied from the receiver, but accepting
+// governance messages from authority.
+func (k Keeper) WithAuthority(authority string) Keeper {
+	k.authority = authority
+	return k
+}
+
 // PushAction sends a vm.Action to the VM controller.
 func (k Keeper) PushAction(ctx sdk.Context, action vm.Action) error {
 	return k.scope.PushAction(ctx, action)
@@ -90,15 +108,26 @@ func (k Keeper) ReceiveChanOpenInit(ctx sdk.Context, order channeltypes.Order, c
 	return nil
 }
 
-// ReceiveSendPacket wraps the keeper's SendPacket function.
-func (k Keeper) ReceiveSendPacket(ctx sdk.Context, packet ibcexported.PacketI) (uint64, error) {
+// ReceiveSendPacket wraps the keeper's SendPacket function, tracking the
+// packet on behalf of target until it is settled.
+func (k Keeper) ReceiveSendPacket(ctx sdk.Context, target string, packet ibcexported.PacketI) (uint64, error) {
 	sourcePort := packet.GetSourcePort()
 	sourceChannel := packet.GetSourceChannel()
 	timeoutHeight := clienttypes.MustParseHeight(packet.GetTimeoutHeight().String())
 	timeoutTimestamp := packet.GetTimeoutTimestamp()
 	data := packet.GetData()
 
-	return k.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	seq, err := k.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return seq, err
+	}
+
+	sent := types.NewPacket(packet)
+	sent.Sequence = seq
+	if err := k.TrackPacket(ctx, target, sent); err != nil {
+		return seq, err
+	}
+	return seq, nil
 }
 
 // SendPacket defines a wrapper function for the channel Keeper's function
@@ -123,13 +152,59 @@ func (k Keeper) ReceiveWriteAcknowledgement(ctx sdk.Context, packet ibcexported.
 // in order to expose it to the vibc IBC handler.
 func (k Keeper) WriteAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
 	channelPacket := agtypes.CopyToChannelPacket(packet)
-	return k.channelKeeper.WriteAcknowledgement(ctx, channelPacket, ack)
+	if err := k.channelKeeper.WriteAcknowledgement(ctx, channelPacket, ack); err != nil {
+		return err
+	}
+	return k.untrackPendingAck(ctx, packet)
+}
+
+// handshakeChannel returns a channel whose handshake step the VM is
+// completing, which must be in the given state.
+func (k Keeper) handshakeChannel(ctx sdk.Context, portID, channelID string, state channeltypes.State) (channeltypes.Channel, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return channel, fmt.Errorf("channel %s/%s not found", portID, channelID)
+	}
+	if channel.State != state {
+		return channel, fmt.Errorf("channel %s/%s is %s, not %s", portID, channelID, channel.State, state)
+	}
+	return channel, nil
+}
+
+// ReceiveWriteOpenInitChannel writes the version that the VM has chosen for a
+// channel it initiated, while the channel awaits the counterparty's
+// ChanOpenTry.  The order and connection hops, if given, must match those of
+// the channel.  Since ibc-go has already written the channel (with an empty
+// version) and emitted its channel_open_init event, only the version of the
+// stored channel is updated, leaving its sequences alone, and a distinct
+// event is emitted.
+func (k Keeper) ReceiveWriteOpenInitChannel(ctx sdk.Context, packet ibcexported.PacketI, order channeltypes.Order, connectionHops []string, version string) error {
+	portID := packet.GetSourcePort()
+	channelID := packet.GetSourceChannel()
+	channel, err := k.handshakeChannel(ctx, portID, channelID, channeltypes.INIT)
+	if err != nil {
+		return err
+	}
+	if order != channeltypes.NONE && order != channel.Ordering {
+		return fmt.Errorf("channel %s/%s is %s, not %s", portID, channelID, channel.Ordering, order)
+	}
+	if len(connectionHops) > 0 && !slices.Equal(connectionHops, channel.ConnectionHops) {
+		return fmt.Errorf("channel %s/%s has connection hops %v, not %v", portID, channelID, channel.ConnectionHops, connectionHops)
+	}
+	channel.Version = version
+	k.channelKeeper.SetChannel(ctx, portID, channelID, channel)
+	ctx.EventManager().EmitEvent(types.NewChannelVersionWrittenEvent(portID, channelID, version))
+	return nil
 }
 
 // ReceiveWriteOpenTryChannel wraps the keeper's WriteOpenTryChannel function.
+// The channel must still await the counterparty's ChanOpenAck.
 func (k Keeper) ReceiveWriteOpenTryChannel(ctx sdk.Context, packet ibcexported.PacketI, order channeltypes.Order, connectionHops []string, version string) error {
 	portID := packet.GetDestPort()
 	channelID := packet.GetDestChannel()
+	if _, err := k.handshakeChannel(ctx, portID, channelID, channeltypes.TRYOPEN); err != nil {
+		return err
+	}
 	counterparty := channeltypes.NewCounterparty(packet.GetSourcePort(), packet.GetSourceChannel())
 	k.WriteOpenTryChannel(ctx, portID, channelID, order, connectionHops, counterparty, version)
 	return nil
@@ -141,6 +216,20 @@ func (k Keeper) WriteOpenTryChannel(ctx sdk.Context, portID, channelID string, o
 	k.channelKeeper.WriteOpenTryChannel(ctx, portID, channelID, order, connectionHops, counterparty, version)
 }
 
+// ReceiveOpenAckExecuted concludes the VM's check of the counterparty version
+// of a channel opened by ChanOpenAck.  Since the channel is already open, a
+// nonempty rejection closes it.
+func (k Keeper) ReceiveOpenAckExecuted(ctx sdk.Context, portID, channelID, rejection string) error {
+	if _, err := k.handshakeChannel(ctx, portID, channelID, channeltypes.OPEN); err != nil {
+		return err
+	}
+	if rejection == "" {
+		return nil
+	}
+	ctx.Logger().Info("VM rejected counterparty version", "port-id", portID, "channel-id", channelID, "reason", rejection)
+	return k.channelKeeper.ChanCloseInit(ctx, portID, channelID)
+}
+
 // ReceiveChanCloseInit is a wrapper function for the channel Keeper's function
 // in order to expose it to the vibc IBC handler.
 func (k Keeper) ReceiveChanCloseInit(ctx sdk.Context, portID, channelID string) error {
@@ -157,6 +246,12 @@ func (k Keeper) ReceiveBindPort(ctx sdk.Context, portID string) error {
 	return k.BindPort(ctx, portID)
 }
 
+// ReceiveRevokePort is a wrapper for RevokePort, in order to expose it to the
+// vibc IBC handler.
+func (k Keeper) ReceiveRevokePort(ctx sdk.Context, portID string) error {
+	return k.RevokePort(ctx, portID)
+}
+
 // BindPort dynamically binds an exact port ID to the vibc IBC module.
 func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
 	return k.scope.BindPort(ctx, portID)
@@ -167,6 +262,11 @@ func (k Keeper) RevokePort(ctx sdk.Context, portID string) error {
 	return k.scope.RevokePort(ctx, portID)
 }
 
+// GetBoundPorts returns the dynamically bound ports.
+func (k Keeper) GetBoundPorts(ctx sdk.Context) ([]types.BoundPort, error) {
+	return k.scope.GetBoundPorts(ctx)
+}
+
 // LoadDynamicPortBindings rehydrates persisted dynamic bindings into the live router.
 func (k Keeper) LoadDynamicPortBindings(ctx sdk.Context) error {
 	return k.scope.LoadBindings(ctx)
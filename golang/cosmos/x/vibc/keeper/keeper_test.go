@@ -0,0 +1,423 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	vibc "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+type mockChannelKeeper struct {
+	channels     map[string]channeltypes.Channel
+	calls        []string
+	lastSequence uint64
+	lastAck      []byte
+}
+
+var _ vibc.ChannelKeeper = (*mockChannelKeeper)(nil)
+
+func (m *mockChannelKeeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	channel, found := m.channels[portID+"/"+channelID]
+	return channel.Version, found
+}
+
+func (m *mockChannelKeeper) GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	channel, found := m.channels[portID+"/"+channelID]
+	return channel, found
+}
+
+func (m *mockChannelKeeper) GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel {
+	var channels []channeltypes.IdentifiedChannel
+	for key, channel := range m.channels {
+		portID, channelID, _ := strings.Cut(key, "/")
+		if strings.HasPrefix(portID, portPrefix) {
+			channels = append(channels, channeltypes.NewIdentifiedChannel(portID, channelID, channel))
+		}
+	}
+	slices.SortFunc(channels, func(a, b channeltypes.IdentifiedChannel) int {
+		return strings.Compare(a.PortId+"/"+a.ChannelId, b.PortId+"/"+b.ChannelId)
+	})
+	return channels
+}
+
+func (m *mockChannelKeeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	_, found := m.channels[portID+"/"+channelID]
+	return m.lastSequence + 1, found
+}
+
+func (m *mockChannelKeeper) GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	_, found := m.channels[portID+"/"+channelID]
+	return 2, found
+}
+
+func (m *mockChannelKeeper) GetNextSequenceAck(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	_, found := m.channels[portID+"/"+channelID]
+	return 1, found
+}
+
+func (m *mockChannelKeeper) SetChannel(ctx sdk.Context, portID, channelID string, channel channeltypes.Channel) {
+	m.calls = append(m.calls, "SetChannel "+portID+"/"+channelID+" "+channel.Version)
+	m.channels[portID+"/"+channelID] = channel
+}
+
+func (m *mockChannelKeeper) SendPacket(ctx sdk.Context, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+	m.lastSequence++
+	return m.lastSequence, nil
+}
+
+func (m *mockChannelKeeper) WriteAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	m.calls = append(m.calls, fmt.Sprintf("WriteAcknowledgement %s/%s %d %t", packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), acknowledgement.Success()))
+	m.lastAck = acknowledgement.Acknowledgement()
+	return nil
+}
+
+func (m *mockChannelKeeper) ChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string, counterparty channeltypes.Counterparty, version string) (string, error) {
+	return "channel-0", nil
+}
+
+func (m *mockChannelKeeper) WriteOpenInitChannel(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, counterparty channeltypes.Counterparty, version string) {
+	m.calls = append(m.calls, "WriteOpenInitChannel "+portID+"/"+channelID+" "+version)
+	m.channels[portID+"/"+channelID] = channeltypes.NewChannel(channeltypes.INIT, order, counterparty, connectionHops, version)
+}
+
+func (m *mockChannelKeeper) WriteOpenTryChannel(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, counterparty channeltypes.Counterparty, version string) {
+	m.calls = append(m.calls, "WriteOpenTryChannel "+portID+"/"+channelID+" "+version)
+	m.channels[portID+"/"+channelID] = channeltypes.NewChannel(channeltypes.TRYOPEN, order, counterparty, connectionHops, version)
+}
+
+func (m *mockChannelKeeper) WriteOpenAckChannel(ctx sdk.Context, portID, channelID, counterpartyVersion, counterpartyChannelID string) {
+}
+
+func (m *mockChannelKeeper) ChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	m.calls = append(m.calls, "ChanCloseInit "+portID+"/"+channelID)
+	channel := m.channels[portID+"/"+channelID]
+	channel.State = channeltypes.CLOSED
+	m.channels[portID+"/"+channelID] = channel
+	return nil
+}
+
+func (m *mockChannelKeeper) TimeoutExecuted(ctx sdk.Context, packet channeltypes.Packet) error {
+	return nil
+}
+
+func TestReceiverCompletesHandshakeSteps(t *testing.T) {
+	ctx := makeTestContext(t)
+	counterparty := channeltypes.NewCounterparty("icahost", "")
+	channelKeeper := &mockChannelKeeper{channels: map[string]channeltypes.Channel{
+		"icacontroller-1/channel-0": channeltypes.NewChannel(channeltypes.INIT, channeltypes.ORDERED, counterparty, []string{"connection-0"}, ""),
+		"port-1/channel-1":          channeltypes.NewChannel(channeltypes.TRYOPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty("port-2", "channel-9"), []string{"connection-0"}, ""),
+		"port-1/channel-2":          channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty("port-2", "channel-8"), []string{"connection-0"}, "v1"),
+	}}
+	receiver := vibc.NewReceiver(NewKeeper(nil, nil, channelKeeper, nil))
+	call := func(request string) error {
+		_, err := receiver.Receive(sdk.WrapSDKContext(ctx), request)
+		return err
+	}
+
+	// initOpenExecuted writes the version of a channel awaiting ChanOpenTry.
+	initOpen := `{"type":"IBC_METHOD","method":"initOpenExecuted","packet":{"source_port":"icacontroller-1","source_channel":"channel-0","destination_port":"icahost"},"order":"ORDERED","hops":["connection-0"],"version":"ics27-1"}`
+	require.NoError(t, call(initOpen))
+	channel := channelKeeper.channels["icacontroller-1/channel-0"]
+	require.Equal(t, "ics27-1", channel.Version)
+	require.Equal(t, counterparty, channel.Counterparty)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, vibc.EventTypeChannelVersionWritten, events[0].Type)
+
+	require.EqualError(t, call(`{"type":"IBC_METHOD","method":"initOpenExecuted","packet":{"source_port":"icacontroller-1","source_channel":"channel-0"},"order":"UNORDERED","version":"x"}`),
+		"channel icacontroller-1/channel-0 is ORDER_ORDERED, not ORDER_UNORDERED")
+	require.EqualError(t, call(`{"type":"IBC_METHOD","method":"initOpenExecuted","packet":{"source_port":"port-1","source_channel":"channel-1"},"version":"x"}`),
+		"channel port-1/channel-1 is STATE_TRYOPEN, not STATE_INIT")
+	require.EqualError(t, call(`{"type":"IBC_METHOD","method":"initOpenExecuted","packet":{"source_port":"port-1","source_channel":"channel-7"},"version":"x"}`),
+		"channel port-1/channel-7 not found")
+
+	// tryOpenExecuted writes the version of a channel awaiting ChanOpenAck.
+	require.NoError(t, call(`{"type":"IBC_METHOD","method":"tryOpenExecuted","packet":{"source_port":"port-2","source_channel":"channel-9","destination_port":"port-1","destination_channel":"channel-1"},"order":"UNORDERED","hops":["connection-0"],"version":"v2"}`))
+	require.Equal(t, "v2", channelKeeper.channels["port-1/channel-1"].Version)
+	require.EqualError(t, call(`{"type":"IBC_METHOD","method":"tryOpenExecuted","packet":{"destination_port":"port-1","destination_channel":"channel-2"},"version":"v2"}`),
+		"channel port-1/channel-2 is STATE_OPEN, not STATE_TRYOPEN")
+
+	// ackOpenExecuted accepts the counterparty version, or closes the channel.
+	require.NoError(t, call(`{"type":"IBC_METHOD","method":"ackOpenExecuted","packet":{"source_port":"port-1","source_channel":"channel-2"}}`))
+	require.Equal(t, channeltypes.OPEN, channelKeeper.channels["port-1/channel-2"].State)
+	require.NoError(t, call(`{"type":"IBC_METHOD","method":"ackOpenExecuted","packet":{"source_port":"port-1","source_channel":"channel-2"},"reason":"unsupported version"}`))
+	require.Equal(t, channeltypes.CLOSED, channelKeeper.channels["port-1/channel-2"].State)
+
+	require.Equal(t, []string{
+		"SetChannel icacontroller-1/channel-0 ics27-1",
+		"WriteOpenTryChannel port-1/channel-1 v2",
+		"ChanCloseInit port-1/channel-2",
+	}, channelKeeper.calls)
+}
+
+func TestInFlightPacketsAreTrackedUntilSettled(t *testing.T) {
+	ctx := makeTestContext(t).WithBlockHeight(7)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	var actions []vm.Action
+	pushAction := func(ctx sdk.Context, action vm.Action) error {
+		actions = append(actions, action)
+		return nil
+	}
+	channelKeeper := &mockChannelKeeper{channels: map[string]channeltypes.Channel{}}
+	keeper := NewKeeper(cdc, runtime.NewKVStoreService(vibcStoreKey), channelKeeper, nil).
+		WithScope(NewDynamicPortScope(nil, nil, pushAction)).
+		WithAuthority("authority")
+	receiver := vibc.NewReceiver(keeper)
+	call := func(request string) string {
+		reply, err := receiver.Receive(sdk.WrapSDKContext(ctx), request)
+		require.NoError(t, err)
+		return reply
+	}
+
+	call(`{"type":"IBC_METHOD","method":"sendPacket","packet":{"source_port":"port-1","source_channel":"channel-0","data":"aGk="},"relativeTimeoutNs":"10"}`)
+	call(`{"type":"IBC_METHOD","method":"sendPacket","packet":{"source_port":"port-1","source_channel":"channel-0","timeout_timestamp":99}}`)
+	call(`{"type":"IBC_METHOD","method":"sendPacket","packet":{"source_port":"port-2","source_channel":"channel-1","timeout_timestamp":99},"target":"agoric1target"}`)
+
+	res, err := keeper.InFlightPackets(ctx, &vibc.QueryInFlightPacketsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 2)
+	require.NotNil(t, res.Pagination.NextKey)
+	require.Equal(t, uint64(1), res.Packets[0].Packet.Sequence)
+	require.Equal(t, []byte("hi"), res.Packets[0].Packet.Data)
+	require.Equal(t, int64(7), res.Packets[0].SendHeight)
+	require.Equal(t, uint64(ctx.BlockTime().UnixNano())+10, res.Packets[0].Packet.TimeoutTimestamp)
+
+	res, err = keeper.InFlightPackets(ctx, &vibc.QueryInFlightPacketsRequest{PortId: "port-2"})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 1)
+	require.Equal(t, "channel-1", res.Packets[0].Packet.SourceChannel)
+	require.Equal(t, "agoric1target", res.Packets[0].Target)
+
+	_, err = keeper.InFlightPackets(ctx, &vibc.QueryInFlightPacketsRequest{ChannelId: "channel-1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// An acknowledgement or timeout settles the packet.
+	packet := channeltypes.NewPacket(nil, 1, "port-1", "channel-0", "port-9", "channel-9", clienttypes.ZeroHeight(), 99)
+	ibcModule := vibc.NewIBCModule(keeper)
+	require.NoError(t, ibcModule.OnAcknowledgementPacket(ctx, "v1", packet, []byte("ok"), nil))
+	packet.SourcePort, packet.SourceChannel = "port-2", "channel-1"
+	packet.Sequence = 3
+	require.NoError(t, ibcModule.OnTimeoutPacket(ctx, "v1", packet, nil))
+	packets, err := keeper.GetInFlightPackets(ctx, "", "")
+	require.NoError(t, err)
+	require.Len(t, packets, 1)
+	require.Equal(t, uint64(2), packets[0].Packet.Sequence)
+
+	// The VM or governance can resync the remaining packets.
+	actions = nil
+	require.Equal(t, "1", call(`{"type":"IBC_METHOD","method":"resyncPackets","packet":{"source_port":"port-1"}}`))
+	require.Len(t, actions, 1)
+	event, ok := actions[0].(vibc.PacketInFlightEvent)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), event.Packet.Sequence)
+	require.Equal(t, int64(7), event.SendHeight)
+
+	msgServer := NewMsgServerImpl(keeper)
+	_, err = msgServer.ResyncPackets(ctx, &vibc.MsgResyncPackets{Authority: "someone"})
+	require.ErrorContains(t, err, "only governance authority")
+	resync, err := msgServer.ResyncPackets(ctx, &vibc.MsgResyncPackets{Authority: "authority", PortId: "port-2"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resync.Resynced)
+}
+
+func TestOverdueAcksAreWrittenAsErrors(t *testing.T) {
+	ctx := makeTestContext(t).WithBlockHeight(10)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	channelKeeper := &mockChannelKeeper{channels: map[string]channeltypes.Channel{}}
+	keeper := NewKeeper(cdc, runtime.NewKVStoreService(vibcStoreKey), channelKeeper, nil).
+		WithScope(NewDynamicPortScope(nil, nil, func(sdk.Context, vm.Action) error { return nil })).
+		WithAuthority("authority")
+	ibcModule := vibc.NewIBCModule(keeper)
+	receive := func(ctx sdk.Context, sequence uint64) {
+		packet := channeltypes.NewPacket(nil, sequence, "port-9", "channel-9", "port-1", "channel-0", clienttypes.ZeroHeight(), 99)
+		require.Nil(t, ibcModule.OnRecvPacket(ctx, "v1", packet, nil))
+	}
+
+	// Without a deadline, the VM may take as long as it likes.
+	receive(ctx, 1)
+
+	msgServer := NewMsgServerImpl(keeper)
+	_, err := msgServer.SetAckDeadline(ctx, &vibc.MsgSetAckDeadline{Authority: "someone", Blocks: 3})
+	require.ErrorContains(t, err, "only governance authority")
+	_, err = msgServer.SetAckDeadline(ctx, &vibc.MsgSetAckDeadline{Authority: "authority", Blocks: 3})
+	require.NoError(t, err)
+	params, err := keeper.Params(ctx, &vibc.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), params.Params.AckDeadlineBlocks)
+
+	receive(ctx, 2)
+	receive(ctx, 3)
+	receive(ctx.WithBlockHeight(11), 4)
+
+	// The VM acknowledges one packet in time.
+	receiver := vibc.NewReceiver(keeper)
+	_, err = receiver.Receive(sdk.WrapSDKContext(ctx), `{"type":"IBC_METHOD","method":"receiveExecuted","packet":{"sequence":3,"source_port":"port-9","source_channel":"channel-9","destination_port":"port-1","destination_channel":"channel-0"},"ack":"b2s="}`)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(12)
+	overdue, err := keeper.OverdueAcks(ctx, &vibc.QueryOverdueAcksRequest{})
+	require.NoError(t, err)
+	require.Empty(t, overdue.Acks)
+	require.NoError(t, keeper.ExpireOverdueAcks(ctx))
+
+	ctx = ctx.WithBlockHeight(13)
+	overdue, err = keeper.OverdueAcks(ctx, &vibc.QueryOverdueAcksRequest{})
+	require.NoError(t, err)
+	require.Len(t, overdue.Acks, 1)
+	require.Equal(t, uint64(2), overdue.Acks[0].Packet.Sequence)
+	require.Equal(t, int64(10), overdue.Acks[0].ReceiveHeight)
+	require.Equal(t, int64(13), overdue.Acks[0].DeadlineHeight)
+	require.NoError(t, keeper.ExpireOverdueAcks(ctx))
+	require.NoError(t, keeper.ExpireOverdueAcks(ctx.WithBlockHeight(14)))
+
+	require.Equal(t, []string{
+		"WriteAcknowledgement port-1/channel-0 3 true",
+		"WriteAcknowledgement port-1/channel-0 2 false",
+		"WriteAcknowledgement port-1/channel-0 4 false",
+	}, channelKeeper.calls)
+	var expired []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == vibc.EventTypeAckExpired {
+			expired = append(expired, event.Attributes[3].Value)
+		}
+	}
+	require.Equal(t, []string{"2", "4"}, expired)
+
+	overdue, err = keeper.OverdueAcks(ctx.WithBlockHeight(100), &vibc.QueryOverdueAcksRequest{})
+	require.NoError(t, err)
+	require.Empty(t, overdue.Acks)
+}
+
+func TestOverdueTransferAcksKeepTheirResult(t *testing.T) {
+	ctx := makeTestContext(t).WithBlockHeight(10)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	channelKeeper := &mockChannelKeeper{channels: map[string]channeltypes.Channel{}}
+	keeper := NewKeeper(cdc, runtime.NewKVStoreService(vibcStoreKey), channelKeeper, nil).
+		WithScope(NewDynamicPortScope(nil, nil, func(sdk.Context, vm.Action) error { return nil })).
+		WithAuthority("authority")
+	require.NoError(t, keeper.SetParams(ctx, vibc.Params{AckDeadlineBlocks: 3}))
+
+	// vtransfer hands the VM a transfer that has already credited the receiver,
+	// along with the transfer module's own acknowledgement.
+	transferAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	transfer := channeltypes.NewPacket([]byte("{}"), 1, "transfer", "channel-9", "transfer", "channel-0", clienttypes.ZeroHeight(), 99)
+	require.NoError(t, keeper.TrackPendingAck(ctx, "agoric1receiver", transfer, transferAck))
+
+	// A packet of another module tracked without its acknowledgement cannot be
+	// safely expired with an error.
+	untracked := channeltypes.NewPacket([]byte("{}"), 2, "transfer", "channel-9", "transfer", "channel-0", clienttypes.ZeroHeight(), 99)
+	require.NoError(t, keeper.TrackPendingAck(ctx, "agoric1receiver", untracked, nil))
+
+	ctx = ctx.WithBlockHeight(13)
+	require.NoError(t, keeper.ExpireOverdueAcks(ctx))
+	require.Equal(t, []string{"WriteAcknowledgement transfer/channel-0 1 true"}, channelKeeper.calls)
+	require.Equal(t, transferAck.Acknowledgement(), channelKeeper.lastAck)
+
+	overdue, err := keeper.OverdueAcks(ctx.WithBlockHeight(100), &vibc.QueryOverdueAcksRequest{})
+	require.NoError(t, err)
+	require.Empty(t, overdue.Acks)
+}
+
+func TestForceCloseJammedOrderedChannel(t *testing.T) {
+	ctx := makeTestContext(t).WithBlockHeight(10)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	counterparty := channeltypes.NewCounterparty("port-9", "channel-9")
+	channelKeeper := &mockChannelKeeper{channels: map[string]channeltypes.Channel{
+		"port-1/channel-0":   channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, counterparty, []string{"connection-0"}, "v1"),
+		"port-1/channel-1":   channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, counterparty, []string{"connection-0"}, "v1"),
+		"port-10/channel-2":  channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, counterparty, []string{"connection-0"}, "v1"),
+		"transfer/channel-3": channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, counterparty, []string{"connection-0"}, "v1"),
+	}}
+	var events []string
+	pushAction := func(_ sdk.Context, action vm.Action) error {
+		if event, ok := action.(vibc.ChannelCloseInitEvent); ok {
+			events = append(events, event.PortID+"/"+event.ChannelID)
+		}
+		return nil
+	}
+	router := NewDynamicPortRouter(porttypes.NewRouter())
+	scope := NewDynamicPortScope(nil, router, pushAction)
+	keeper := NewKeeper(cdc, runtime.NewKVStoreService(vibcStoreKey), channelKeeper, nil).
+		WithScope(scope).
+		WithAuthority("authority")
+	ibcModule := vibc.NewIBCModule(keeper)
+	router.AddLegacyPrefixRoute("port-", ibcModule)
+
+	// The VM has sent a packet and received two that it has yet to acknowledge.
+	_, err := keeper.SendPacket(ctx, "port-1", "channel-0", clienttypes.NewHeight(0, 100), 0, []byte("data"))
+	require.NoError(t, err)
+	require.NoError(t, keeper.TrackPacket(ctx, "", vibc.Packet{Sequence: 1, SourcePort: "port-1", SourceChannel: "channel-0"}))
+	for _, sequence := range []uint64{1, 2} {
+		packet := channeltypes.NewPacket(nil, sequence, "port-9", "channel-9", "port-1", "channel-0", clienttypes.ZeroHeight(), 99)
+		require.Nil(t, ibcModule.OnRecvPacket(ctx, "v1", packet, nil))
+	}
+
+	_, err = keeper.ChannelDiagnostics(ctx, &vibc.QueryChannelDiagnosticsRequest{})
+	require.ErrorContains(t, err, "port is required")
+	diagnostics, err := keeper.ChannelDiagnostics(ctx, &vibc.QueryChannelDiagnosticsRequest{PortId: "port-1"})
+	require.NoError(t, err)
+	require.Len(t, diagnostics.Channels, 2)
+	require.Equal(t, vibc.ChannelDiagnostic{
+		PortId:                   "port-1",
+		ChannelId:                "channel-0",
+		State:                    "STATE_OPEN",
+		Ordering:                 "ORDER_ORDERED",
+		NextSequenceSend:         2,
+		NextSequenceRecv:         2,
+		NextSequenceAck:          1,
+		InFlightPackets:          1,
+		OldestInFlightSequence:   1,
+		PendingAcks:              2,
+		OldestPendingAckSequence: 1,
+	}, diagnostics.Channels[0])
+	require.Equal(t, "channel-1", diagnostics.Channels[1].ChannelId)
+	require.Zero(t, diagnostics.Channels[1].PendingAcks)
+
+	diagnostics, err = keeper.ChannelDiagnostics(ctx, &vibc.QueryChannelDiagnosticsRequest{PortId: "port-10"})
+	require.NoError(t, err)
+	require.Len(t, diagnostics.Channels, 1)
+	require.Equal(t, "channel-2", diagnostics.Channels[0].ChannelId)
+
+	msgServer := NewMsgServerImpl(keeper)
+	_, err = msgServer.ForceCloseChannel(ctx, &vibc.MsgForceCloseChannel{Authority: "someone", PortId: "port-1", ChannelId: "channel-0"})
+	require.ErrorContains(t, err, "only governance authority")
+	_, err = msgServer.ForceCloseChannel(ctx, &vibc.MsgForceCloseChannel{Authority: "authority", PortId: "transfer", ChannelId: "channel-3"})
+	require.ErrorContains(t, err, "not owned by the VM")
+	_, err = msgServer.ForceCloseChannel(ctx, &vibc.MsgForceCloseChannel{Authority: "authority", PortId: "port-1", ChannelId: "channel-1"})
+	require.ErrorContains(t, err, "not ORDERED")
+	_, err = msgServer.ForceCloseChannel(ctx, &vibc.MsgForceCloseChannel{Authority: "authority", PortId: "port-1", ChannelId: "channel-0"})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"ChanCloseInit port-1/channel-0"}, channelKeeper.calls)
+	require.Equal(t, []string{"port-1/channel-0"}, events)
+	diagnostics, err = keeper.ChannelDiagnostics(ctx, &vibc.QueryChannelDiagnosticsRequest{PortId: "port-1", ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Len(t, diagnostics.Channels, 1)
+	require.Equal(t, "STATE_CLOSED", diagnostics.Channels[0].State)
+	require.Zero(t, diagnostics.Channels[0].PendingAcks)
+	require.Equal(t, uint64(1), diagnostics.Channels[0].InFlightPackets)
+
+	var abandoned []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == vibc.EventTypeChannelForceClosed {
+			abandoned = append(abandoned, event.Attributes[3].Value)
+		}
+	}
+	require.Equal(t, []string{"2"}, abandoned)
+}
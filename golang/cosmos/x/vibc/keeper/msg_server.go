@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktypeserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// msgServer names its keeper rather than embedding it, since the keeper's own
+// SendPacket would be ambiguous with the MsgServer method.
+type msgServer struct {
+	types.UnimplementedMsgServer
+	keeper Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the vibc MsgServer interface
+// for the provided Keeper.  SendPacket remains unimplemented.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{keeper: keeper}
+}
+
+var _ types.MsgServer = (*msgServer)(nil)
+
+func (m msgServer) ResyncPackets(goCtx context.Context, msg *types.MsgResyncPackets) (*types.MsgResyncPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(m.keeper.authority) == 0 || msg.Authority != m.keeper.authority {
+		return nil, sdkerrors.Wrap(sdktypeserrors.ErrUnauthorized, "only governance authority can call ResyncPackets")
+	}
+
+	resynced, err := m.keeper.ResyncPackets(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdktypeserrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgResyncPacketsResponse{Resynced: resynced}, nil
+}
+
+func (m msgServer) SetAckDeadline(goCtx context.Context, msg *types.MsgSetAckDeadline) (*types.MsgSetAckDeadlineResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(m.keeper.authority) == 0 || msg.Authority != m.keeper.authority {
+		return nil, sdkerrors.Wrap(sdktypeserrors.ErrUnauthorized, "only governance authority can call SetAckDeadline")
+	}
+
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params.AckDeadlineBlocks = msg.Blocks
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAckDeadlineResponse{}, nil
+}
+
+func (m msgServer) ForceCloseChannel(goCtx context.Context, msg *types.MsgForceCloseChannel) (*types.MsgForceCloseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(m.keeper.authority) == 0 || msg.Authority != m.keeper.authority {
+		return nil, sdkerrors.Wrap(sdktypeserrors.ErrUnauthorized, "only governance authority can call ForceCloseChannel")
+	}
+
+	if err := m.keeper.ForceCloseChannel(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, sdkerrors.Wrap(sdktypeserrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgForceCloseChannelResponse{}, nil
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+)
+
+// inFlightPacketStoreKeyPrefix maps "<port>/<channel>/<sequence>" to the
+// InFlightPacket sent with that sequence.  Port and channel identifiers cannot
+// contain "/", and the sequence is big-endian so that packets of a channel are
+// ordered by it.
+const inFlightPacketStoreKeyPrefix = "inFlightPacket-"
+
+// channelPrefix returns the key prefix of the packets of a port (or channel of
+// it), or nil if portID is empty.
+func channelPrefix(portID, channelID string) []byte {
+	if len(portID) == 0 {
+		return nil
+	}
+	if len(channelID) == 0 {
+		return []byte(portID + "/")
+	}
+	return []byte(portID + "/" + channelID + "/")
+}
+
+// packetKey returns the key of a packet sent or received on a channel.
+func packetKey(portID, channelID string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(channelPrefix(portID, channelID), sequence)
+}
+
+// openInFlightPacketStore returns the store of in-flight packets of the port
+// (or channel of it), if given.  It returns false if the keeper has no store.
+func (k Keeper) openInFlightPacketStore(ctx sdk.Context, portID, channelID string) (prefix.Store, bool) {
+	store, ok := k.openStore(ctx)
+	if !ok {
+		return prefix.Store{}, false
+	}
+	keyPrefix := append([]byte(inFlightPacketStoreKeyPrefix), channelPrefix(portID, channelID)...)
+	return prefix.NewStore(store, keyPrefix), true
+}
+
+// TrackPacket records a packet sent on behalf of the VM (and to be reported to
+// target, if nonempty) until it is acknowledged or times out.
+func (k Keeper) TrackPacket(ctx sdk.Context, target string, packet types.Packet) error {
+	store, ok := k.openInFlightPacketStore(ctx, "", "")
+	if !ok {
+		return nil
+	}
+	inFlight := types.InFlightPacket{
+		Packet:     packet,
+		Target:     target,
+		SendHeight: ctx.BlockHeight(),
+	}
+	bz, err := k.cdc.Marshal(&inFlight)
+	if err != nil {
+		return err
+	}
+	store.Set(packetKey(packet.SourcePort, packet.SourceChannel, packet.Sequence), bz)
+	return nil
+}
+
+// UntrackPacket forgets a packet that has been acknowledged or has timed out.
+func (k Keeper) UntrackPacket(ctx sdk.Context, packet ibcexported.PacketI) {
+	store, ok := k.openInFlightPacketStore(ctx, "", "")
+	if !ok {
+		return
+	}
+	store.Delete(packetKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+}
+
+// GetInFlightPackets returns the packets in flight from the port (or channel
+// of it), if given, ordered by port, channel and then sequence.
+func (k Keeper) GetInFlightPackets(ctx sdk.Context, portID, channelID string) ([]types.InFlightPacket, error) {
+	packets := []types.InFlightPacket{}
+	store, ok := k.openInFlightPacketStore(ctx, portID, channelID)
+	if !ok {
+		return packets, nil
+	}
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var inFlight types.InFlightPacket
+		if err := k.cdc.Unmarshal(iterator.Value(), &inFlight); err != nil {
+			return nil, err
+		}
+		packets = append(packets, inFlight)
+	}
+	return packets, nil
+}
+
+// ResyncPackets pushes a PacketInFlightEvent for each packet in flight from
+// the port (or channel of it), if given, and returns how many were pushed.
+func (k Keeper) ResyncPackets(ctx sdk.Context, portID, channelID string) (uint64, error) {
+	if err := types.ValidatePacketFilter(portID, channelID); err != nil {
+		return 0, err
+	}
+	packets, err := k.GetInFlightPackets(ctx, portID, channelID)
+	if err != nil {
+		return 0, err
+	}
+	for _, inFlight := range packets {
+		if err := k.PushAction(ctx, types.NewPacketInFlightEvent(inFlight)); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(packets)), nil
+}
+
+// ReceiveResyncPackets is a wrapper for ResyncPackets, in order to expose it to
+// the vibc IBC handler.
+func (k Keeper) ReceiveResyncPackets(ctx sdk.Context, portID, channelID string) (uint64, error) {
+	return k.ResyncPackets(ctx, portID, channelID)
+}
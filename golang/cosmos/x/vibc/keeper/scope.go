@@ -10,8 +10,11 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
 )
 
+// boundPortStoreKeyPrefix maps each dynamically bound port ID to its
+// BoundPort.
 const boundPortStoreKeyPrefix = "boundPort-"
 
 type DynamicPortScope struct {
@@ -41,6 +44,7 @@ func (s *DynamicPortScope) PushAction(ctx sdk.Context, action vm.Action) error {
 	return s.pushAction(ctx, action)
 }
 
+// BindPort binds portID to the VM, recording the block height of the binding.
 func (s *DynamicPortScope) BindPort(ctx sdk.Context, portID string) error {
 	if s.router == nil {
 		return fmt.Errorf("dynamic port router is not configured")
@@ -56,11 +60,17 @@ func (s *DynamicPortScope) BindPort(ctx sdk.Context, portID string) error {
 		return err
 	}
 
-	store := s.boundPortStore(ctx)
-	store.Set([]byte(portID), []byte{1})
+	binding := types.BoundPort{PortId: portID, BindHeight: ctx.BlockHeight()}
+	bz, err := binding.Marshal()
+	if err != nil {
+		return err
+	}
+	s.boundPortStore(ctx).Set([]byte(portID), bz)
+	ctx.EventManager().EmitEvent(types.NewPortBoundEvent(binding))
 	return nil
 }
 
+// RevokePort unbinds portID from the VM.
 func (s *DynamicPortScope) RevokePort(ctx sdk.Context, portID string) error {
 	if s.router == nil {
 		return fmt.Errorf("dynamic port router is not configured")
@@ -70,18 +80,29 @@ func (s *DynamicPortScope) RevokePort(ctx sdk.Context, portID string) error {
 	}
 
 	store := s.boundPortStore(ctx)
-	if !store.Has([]byte(portID)) {
+	bz := store.Get([]byte(portID))
+	if bz == nil {
 		return fmt.Errorf("port %s is not dynamically bound", portID)
 	}
+	binding, err := types.UnmarshalBoundPort(portID, bz)
+	if err != nil {
+		return err
+	}
 
 	store.Delete([]byte(portID))
 	if err := s.router.RevokePort(portID); err != nil {
-		store.Set([]byte(portID), []byte{1})
+		store.Set([]byte(portID), bz)
 		return err
 	}
+	ctx.EventManager().EmitEvent(types.NewPortRevokedEvent(binding))
 	return nil
 }
 
+// OwnsPort returns whether portID is routed to the VM.
+func (s *DynamicPortScope) OwnsPort(portID string) bool {
+	return s.router != nil && s.router.HasDynamicRoute(portID)
+}
+
 func (s *DynamicPortScope) LoadBindings(ctx sdk.Context) error {
 	if s.router == nil {
 		return fmt.Errorf("dynamic port router is not configured")
@@ -112,3 +133,21 @@ func (s *DynamicPortScope) boundPortStore(ctx sdk.Context) prefix.Store {
 	store := runtime.KVStoreAdapter(kvstore)
 	return prefix.NewStore(store, []byte(boundPortStoreKeyPrefix))
 }
+
+// GetBoundPorts returns the dynamically bound ports, ordered by port ID.
+func (s *DynamicPortScope) GetBoundPorts(ctx sdk.Context) ([]types.BoundPort, error) {
+	ports := []types.BoundPort{}
+	if s.storeService == nil {
+		return ports, nil
+	}
+	iter := s.boundPortStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		binding, err := types.UnmarshalBoundPort(string(iter.Key()), iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		ports = append(ports, binding)
+	}
+	return ports, nil
+}
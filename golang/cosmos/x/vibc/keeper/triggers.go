@@ -12,11 +12,13 @@ import (
 func (k Keeper) TriggerWriteAcknowledgement(
 	ctx sdk.Context,
 	target string,
+	hook *agtypes.AddressHookPayload,
 	packet ibcexported.PacketI,
 	acknowledgement ibcexported.Acknowledgement,
 ) error {
 	event := types.WriteAcknowledgementEvent{
 		Target:          target,
+		Hook:            hook,
 		Packet:          agtypes.CopyToIBCPacket(packet),
 		Acknowledgement: acknowledgement.Acknowledgement(),
 	}
@@ -32,6 +34,7 @@ func (k Keeper) TriggerWriteAcknowledgement(
 func (k Keeper) TriggerOnAcknowledgementPacket(
 	ctx sdk.Context,
 	target string,
+	hook *agtypes.AddressHookPayload,
 	channelVersion string,
 	packet ibcexported.PacketI,
 	acknowledgement []byte,
@@ -39,6 +42,7 @@ func (k Keeper) TriggerOnAcknowledgementPacket(
 ) error {
 	event := types.AcknowledgementPacketEvent{
 		Target:          target,
+		Hook:            hook,
 		ChannelVersion:  channelVersion,
 		Packet:          agtypes.CopyToIBCPacket(packet),
 		Acknowledgement: acknowledgement,
@@ -56,12 +60,14 @@ func (k Keeper) TriggerOnAcknowledgementPacket(
 func (k Keeper) TriggerOnTimeoutPacket(
 	ctx sdk.Context,
 	target string,
+	hook *agtypes.AddressHookPayload,
 	channelVersion string,
 	packet ibcexported.PacketI,
 	relayer sdk.AccAddress,
 ) error {
 	event := types.TimeoutPacketEvent{
 		Target:         target,
+		Hook:           hook,
 		ChannelVersion: channelVersion,
 		Packet:         agtypes.CopyToIBCPacket(packet),
 		Relayer:        relayer,
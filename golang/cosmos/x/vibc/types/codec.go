@@ -30,12 +30,18 @@ func init() {
 // RegisterCodec registers concrete types on the Amino codec
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSendPacket{}, ModuleName+"/SendPacket")
+	legacy.RegisterAminoMsg(cdc, &MsgResyncPackets{}, ModuleName+"/ResyncPackets")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAckDeadline{}, ModuleName+"/SetAckDeadline")
+	legacy.RegisterAminoMsg(cdc, &MsgForceCloseChannel{}, ModuleName+"/ForceCloseChannel")
 }
 
 // RegisterInterfaces registers the x/swingset interfaces types with the interface registry
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSendPacket{},
+		&MsgResyncPackets{},
+		&MsgSetAckDeadline{},
+		&MsgForceCloseChannel{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
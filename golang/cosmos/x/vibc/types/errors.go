@@ -0,0 +1,9 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// ErrAckDeadlineExceeded is the error acknowledged on the VM's behalf for a
+// packet that it did not acknowledge in time.
+var ErrAckDeadlineExceeded = sdkerrors.Register(ModuleName, 2, "acknowledgement deadline exceeded")
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	EventTypePortBound   = "vibc_port_bound"
+	EventTypePortRevoked = "vibc_port_revoked"
+	EventTypeAckExpired  = "vibc_ack_expired"
+
+	EventTypeChannelForceClosed    = "vibc_channel_force_closed"
+	EventTypeChannelVersionWritten = "vibc_channel_version_written"
+
+	AttributeKeyPortID        = "port_id"
+	AttributeKeyBindHeight    = "bind_height"
+	AttributeKeyChannelID     = "channel_id"
+	AttributeKeySequence      = "sequence"
+	AttributeKeyTarget        = "target"
+	AttributeKeyReceiveHeight = "receive_height"
+	AttributeKeyAbandonedAcks = "abandoned_acks"
+	AttributeKeyVersion       = "version"
+)
+
+// NewPortBoundEvent describes a port dynamically bound by the VM.
+func NewPortBoundEvent(binding BoundPort) sdk.Event {
+	return sdk.NewEvent(
+		EventTypePortBound,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyPortID, binding.PortId),
+		sdk.NewAttribute(AttributeKeyBindHeight, strconv.FormatInt(binding.BindHeight, 10)),
+	)
+}
+
+// NewPortRevokedEvent describes the revocation of a dynamically bound port.
+func NewPortRevokedEvent(binding BoundPort) sdk.Event {
+	return sdk.NewEvent(
+		EventTypePortRevoked,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyPortID, binding.PortId),
+	)
+}
+
+// NewAckExpiredEvent describes the error acknowledgement written for a packet
+// that the VM did not acknowledge by its deadline.
+func NewAckExpiredEvent(pending PendingAck) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeAckExpired,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyPortID, pending.Packet.DestinationPort),
+		sdk.NewAttribute(AttributeKeyChannelID, pending.Packet.DestinationChannel),
+		sdk.NewAttribute(AttributeKeySequence, strconv.FormatUint(pending.Packet.Sequence, 10)),
+		sdk.NewAttribute(AttributeKeyTarget, pending.Target),
+		sdk.NewAttribute(AttributeKeyReceiveHeight, strconv.FormatInt(pending.ReceiveHeight, 10)),
+	)
+}
+
+// NewChannelForceClosedEvent describes a channel closed by governance, and
+// how many acknowledgements owed by the VM were abandoned with it.
+func NewChannelForceClosedEvent(portID, channelID string, abandonedAcks uint64) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeChannelForceClosed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyPortID, portID),
+		sdk.NewAttribute(AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(AttributeKeyAbandonedAcks, strconv.FormatUint(abandonedAcks, 10)),
+	)
+}
+
+// NewChannelVersionWrittenEvent describes the version that the VM chose for a
+// channel it initiated, after ibc-go had already written the channel.
+func NewChannelVersionWrittenEvent(portID, channelID, version string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeChannelVersionWritten,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyPortID, portID),
+		sdk.NewAttribute(AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(AttributeKeyVersion, version),
+	)
+}
@@ -17,6 +17,11 @@ type BankKeeper interface {
 type ChannelKeeper interface {
 	GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool)
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channel.Channel, found bool)
+	SetChannel(ctx sdk.Context, portID, channelID string, channel channel.Channel)
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channel.IdentifiedChannel
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceAck(ctx sdk.Context, portID, channelID string) (uint64, bool)
 	SendPacket(
 		ctx sdk.Context,
 		sourcePort string,
@@ -30,25 +30,68 @@ var (
 type IBCModuleImpl interface {
 	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
 	PushAction(ctx sdk.Context, action vm.Action) error
+	// UntrackPacket forgets a packet sent by the VM once it has been
+	// acknowledged or has timed out.
+	UntrackPacket(ctx sdk.Context, packet exported.PacketI)
+	// TrackPendingAck records a packet received by the VM (or by target), to
+	// be acknowledged by its deadline, else with ack if it is not nil.
+	TrackPendingAck(ctx sdk.Context, target string, packet exported.PacketI, ack exported.Acknowledgement) error
 }
 
+// IBCModule relays the channel and packet callbacks of VM-owned ports to the
+// VM.  It implements no channel upgrade (ICS-004 upgradability) callbacks,
+// since ibc-go v10 removed channel upgrades.  A VM-owned channel keeps its
+// version and ordering until it is closed; to migrate, the VM must open a new
+// channel.
 type IBCModule struct {
 	impl IBCModuleImpl
+	// asyncVersions is whether the VM chooses channel versions asynchronously,
+	// defaulting to AsyncVersions.
+	asyncVersions bool
 }
 
 func NewIBCModule(impl IBCModuleImpl) IBCModule {
 	return IBCModule{
-		impl: impl,
+		impl:          impl,
+		asyncVersions: AsyncVersions,
 	}
 }
 
+// WithAsyncVersions returns a copy of the IBCModule in which the VM chooses
+// channel versions asynchronously (or not).  Since ibc-go writes each handshake
+// step as soon as its callback returns, the VM then completes the step
+// afterwards: an "initOpenExecuted" or "tryOpenExecuted" method writes the
+// chosen version of a channel still awaiting its counterparty, and an
+// "ackOpenExecuted" method accepts or rejects the counterparty version of a
+// channel opened by ChanOpenAck.
+func (im IBCModule) WithAsyncVersions(async bool) IBCModule {
+	im.asyncVersions = async
+	return im
+}
+
+func init() {
+	vm.RegisterActions(
+		&WriteAcknowledgementEvent{},
+		&ChannelOpenInitEvent{},
+		&ChannelOpenTryEvent{},
+		&ChannelOpenAckEvent{},
+		&ChannelOpenConfirmEvent{},
+		&ChannelCloseInitEvent{},
+		&ChannelCloseConfirmEvent{},
+		&ReceivePacketEvent{},
+		&AcknowledgementPacketEvent{},
+		&TimeoutPacketEvent{},
+	)
+}
+
 type WriteAcknowledgementEvent struct {
 	*vm.ActionHeader `actionType:"IBC_EVENT"`
-	Event            string            `json:"event" default:"writeAcknowledgement"`
-	Target           string            `json:"target"`
-	Packet           agtypes.IBCPacket `json:"packet"`
-	Acknowledgement  []byte            `json:"acknowledgement"`
-	Relayer          sdk.AccAddress    `json:"relayer"`
+	Event            string                      `json:"event" default:"writeAcknowledgement"`
+	Target           string                      `json:"target"`
+	Hook             *agtypes.AddressHookPayload `json:"hook,omitempty"`
+	Packet           agtypes.IBCPacket           `json:"packet"`
+	Acknowledgement  []byte                      `json:"acknowledgement"`
+	Relayer          sdk.AccAddress              `json:"relayer"`
 }
 
 type ChannelOpenInitEvent struct {
@@ -81,7 +124,7 @@ func (im IBCModule) OnChanOpenInit(
 		ChannelID:      channelID,
 		Counterparty:   counterparty,
 		Version:        version,
-		AsyncVersions:  AsyncVersions,
+		AsyncVersions:  im.asyncVersions,
 	}
 
 	err := im.impl.PushAction(ctx, event)
@@ -126,7 +169,7 @@ func (im IBCModule) OnChanOpenTry(
 		ChannelID:      channelID,
 		Counterparty:   counterparty,
 		Version:        counterpartyVersion,
-		AsyncVersions:  AsyncVersions,
+		AsyncVersions:  im.asyncVersions,
 	}
 
 	err := im.impl.PushAction(ctx, event)
@@ -152,6 +195,9 @@ type ChannelOpenAckEvent struct {
 	CounterpartyVersion string                    `json:"counterpartyVersion"`
 	Counterparty        channeltypes.Counterparty `json:"counterparty"`
 	ConnectionHops      []string                  `json:"connectionHops"`
+	// AsyncVersions is whether the VM must follow up with "ackOpenExecuted" to
+	// accept or reject the counterparty version.
+	AsyncVersions bool `json:"asyncVersions"`
 }
 
 func (im IBCModule) OnChanOpenAck(
@@ -172,6 +218,7 @@ func (im IBCModule) OnChanOpenAck(
 		CounterpartyVersion: counterpartyVersion,
 		Counterparty:        channel.Counterparty,
 		ConnectionHops:      channel.ConnectionHops,
+		AsyncVersions:       im.asyncVersions,
 	}
 
 	return im.impl.PushAction(ctx, event)
@@ -279,17 +326,23 @@ func (im IBCModule) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	// The VM acknowledges the packet asynchronously.
+	if err := im.impl.TrackPendingAck(ctx, "", packet, nil); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
 	return nil
 }
 
 type AcknowledgementPacketEvent struct {
 	*vm.ActionHeader `actionType:"IBC_EVENT"`
-	Event            string            `json:"event" default:"acknowledgementPacket"`
-	Target           string            `json:"target,omitempty"`
-	ChannelVersion   string            `json:"channelVersion"`
-	Packet           agtypes.IBCPacket `json:"packet"`
-	Acknowledgement  []byte            `json:"acknowledgement"`
-	Relayer          sdk.AccAddress    `json:"relayer"`
+	Event            string                      `json:"event" default:"acknowledgementPacket"`
+	Target           string                      `json:"target,omitempty"`
+	Hook             *agtypes.AddressHookPayload `json:"hook,omitempty"`
+	ChannelVersion   string                      `json:"channelVersion"`
+	Packet           agtypes.IBCPacket           `json:"packet"`
+	Acknowledgement  []byte                      `json:"acknowledgement"`
+	Relayer          sdk.AccAddress              `json:"relayer"`
 }
 
 func (im IBCModule) OnAcknowledgementPacket(
@@ -299,6 +352,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	im.impl.UntrackPacket(ctx, packet)
 	event := AcknowledgementPacketEvent{
 		ChannelVersion: channelVersion,
 		Packet:          agtypes.CopyToIBCPacket(packet),
@@ -316,11 +370,12 @@ func (im IBCModule) OnAcknowledgementPacket(
 
 type TimeoutPacketEvent struct {
 	*vm.ActionHeader `actionType:"IBC_EVENT"`
-	Event            string            `json:"event" default:"timeoutPacket"`
-	Target           string            `json:"target,omitempty"`
-	ChannelVersion   string            `json:"channelVersion"`
-	Packet           agtypes.IBCPacket `json:"packet"`
-	Relayer          sdk.AccAddress    `json:"relayer"`
+	Event            string                      `json:"event" default:"timeoutPacket"`
+	Target           string                      `json:"target,omitempty"`
+	Hook             *agtypes.AddressHookPayload `json:"hook,omitempty"`
+	ChannelVersion   string                      `json:"channelVersion"`
+	Packet           agtypes.IBCPacket           `json:"packet"`
+	Relayer          sdk.AccAddress              `json:"relayer"`
 }
 
 func (im IBCModule) OnTimeoutPacket(
@@ -329,6 +384,7 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	im.impl.UntrackPacket(ctx, packet)
 	event := TimeoutPacketEvent{
 		ChannelVersion: channelVersion,
 		Packet:  agtypes.CopyToIBCPacket(packet),
@@ -47,10 +47,10 @@ func (p Packet) ValidateBasic() error {
 	return channelPacket.ValidateBasic()
 }
 
-// NewMsgSendPacket returns a new send request
-func NewMsgSendPacket(packet ibcexported.PacketI, sender sdk.AccAddress) *MsgSendPacket {
+// NewPacket returns a copy of packet as a local Packet.
+func NewPacket(packet ibcexported.PacketI) Packet {
 	timeoutHeight := clienttypes.MustParseHeight(packet.GetTimeoutHeight().String())
-	localPacket := Packet{
+	return Packet{
 		Sequence:       packet.GetSequence(),
 		SourcePort:     packet.GetSourcePort(),
 		SourceChannel:  packet.GetSourceChannel(),
@@ -63,8 +63,12 @@ func NewMsgSendPacket(packet ibcexported.PacketI, sender sdk.AccAddress) *MsgSen
 		},
 		TimeoutTimestamp: packet.GetTimeoutTimestamp(),
 	}
+}
+
+// NewMsgSendPacket returns a new send request
+func NewMsgSendPacket(packet ibcexported.PacketI, sender sdk.AccAddress) *MsgSendPacket {
 	return &MsgSendPacket{
-		Packet: localPacket,
+		Packet: NewPacket(packet),
 		Sender: sender,
 	}
 }
@@ -125,3 +129,42 @@ func (p Packet) GetTimeoutHeight() ibcexported.Height {
 func (p Packet) GetTimeoutTimestamp() uint64 {
 	return p.TimeoutTimestamp
 }
+
+var _ sdk.Msg = &MsgResyncPackets{}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgResyncPackets) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority: %s", err)
+	}
+	if err := ValidatePacketFilter(msg.PortId, msg.ChannelId); err != nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetAckDeadline{}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgSetAckDeadline) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority: %s", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgForceCloseChannel{}
+
+// ValidateBasic implements sdk.HasValidateBasic.
+func (msg *MsgForceCloseChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority: %s", err)
+	}
+	if len(msg.ChannelId) == 0 {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, "channel is required")
+	}
+	if err := ValidatePacketFilter(msg.PortId, msg.ChannelId); err != nil {
+		return sdkioerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
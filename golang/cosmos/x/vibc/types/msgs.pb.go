@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -121,6 +122,210 @@ func (m *MsgSendPacketResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendPacketResponse proto.InternalMessageInfo
 
+// MsgResyncPackets pushes a "packetInFlight" event to the VM for each packet
+// in flight, optionally restricted to a port or a channel of it.
+type MsgResyncPackets struct {
+	// authority is the address that controls the module (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// port_id, if nonempty, restricts the resync to packets sent from the port.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id, if nonempty, restricts the resync to packets sent on the
+	// channel of port_id.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgResyncPackets) Reset()         { *m = MsgResyncPackets{} }
+func (m *MsgResyncPackets) String() string { return proto.CompactTextString(m) }
+func (*MsgResyncPackets) ProtoMessage()    {}
+func (*MsgResyncPackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78e9bb7be62a4c00, []int{2}
+}
+func (m *MsgResyncPackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResyncPackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResyncPackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResyncPackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResyncPackets.Merge(m, src)
+}
+func (m *MsgResyncPackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResyncPackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResyncPackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResyncPackets proto.InternalMessageInfo
+
+func (m *MsgResyncPackets) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResyncPackets) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgResyncPackets) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MsgResyncPacketsResponse reports how many packets were re-emitted.
+type MsgResyncPacketsResponse struct {
+	Resynced uint64 `protobuf:"varint,1,opt,name=resynced,proto3" json:"resynced,omitempty"`
+}
+
+func (m *MsgResyncPacketsResponse) Reset()         { *m = MsgResyncPacketsResponse{} }
+func (m *MsgResyncPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResyncPacketsResponse) ProtoMessage()    {}
+func (*MsgResyncPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78e9bb7be62a4c00, []int{3}
+}
+func (m *MsgResyncPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResyncPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResyncPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResyncPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResyncPacketsResponse.Merge(m, src)
+}
+func (m *MsgResyncPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResyncPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResyncPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResyncPacketsResponse proto.InternalMessageInfo
+
+func (m *MsgResyncPacketsResponse) GetResynced() uint64 {
+	if m != nil {
+		return m.Resynced
+	}
+	return 0
+}
+
+// MsgSetAckDeadline sets the ack_deadline_blocks param.  Packets already
+// received keep the deadline they were received with.
+type MsgSetAckDeadline struct {
+	// authority is the address that controls the module (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// blocks is the new deadline, or zero to disable it.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *MsgSetAckDeadline) Reset()         { *m = MsgSetAckDeadline{} }
+func (m *MsgSetAckDeadline) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAckDeadline) ProtoMessage()    {}
+func (*MsgSetAckDeadline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78e9bb7be62a4c00, []int{4}
+}
+func (m *MsgSetAckDeadline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAckDeadline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAckDeadline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAckDeadline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAckDeadline.Merge(m, src)
+}
+func (m *MsgSetAckDeadline) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAckDeadline) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAckDeadline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAckDeadline proto.InternalMessageInfo
+
+func (m *MsgSetAckDeadline) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetAckDeadline) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// MsgSetAckDeadlineResponse is an empty reply.
+type MsgSetAckDeadlineResponse struct {
+}
+
+func (m *MsgSetAckDeadlineResponse) Reset()         { *m = MsgSetAckDeadlineResponse{} }
+func (m *MsgSetAckDeadlineResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAckDeadlineResponse) ProtoMessage()    {}
+func (*MsgSetAckDeadlineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78e9bb7be62a4c00, []int{5}
+}
+func (m *MsgSetAckDeadlineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAckDeadlineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAckDeadlineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAckDeadlineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAckDeadlineResponse.Merge(m, src)
+}
+func (m *MsgSetAckDeadlineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAckDeadlineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAckDeadlineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAckDeadlineResponse proto.InternalMessageInfo
+
 // Packet defines a type that carries data across different chains through IBC.
 // The fields in the Packet correspond to the fields in the IBC packet
 // definition in ibc/core/channel/v1/channel.proto, but with amino and gogoproto
@@ -152,7 +357,7 @@ func (m *Packet) Reset()         { *m = Packet{} }
 func (m *Packet) String() string { return proto.CompactTextString(m) }
 func (*Packet) ProtoMessage()    {}
 func (*Packet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78e9bb7be62a4c00, []int{2}
+	return fileDescriptor_78e9bb7be62a4c00, []int{6}
 }
 func (m *Packet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +400,7 @@ func (m *Height) Reset()         { *m = Height{} }
 func (m *Height) String() string { return proto.CompactTextString(m) }
 func (*Height) ProtoMessage()    {}
 func (*Height) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78e9bb7be62a4c00, []int{3}
+	return fileDescriptor_78e9bb7be62a4c00, []int{7}
 }
 func (m *Height) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,56 +429,179 @@ func (m *Height) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Height proto.InternalMessageInfo
 
+// MsgForceCloseChannel closes an ordered channel of a VM-owned port, telling
+// the VM as if it had closed the channel itself.  The acknowledgements that
+// the VM still owes on the channel are abandoned.
+type MsgForceCloseChannel struct {
+	// authority is the address that controls the module (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgForceCloseChannel) Reset()         { *m = MsgForceCloseChannel{} }
+func (m *MsgForceCloseChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgForceCloseChannel) ProtoMessage()    {}
+func (*MsgForceCloseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78e9bb7be62a4c00, []int{8}
+}
+func (m *MsgForceCloseChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceCloseChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceCloseChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceCloseChannel.Merge(m, src)
+}
+func (m *MsgForceCloseChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceCloseChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceCloseChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceCloseChannel proto.InternalMessageInfo
+
+func (m *MsgForceCloseChannel) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgForceCloseChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgForceCloseChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MsgForceCloseChannelResponse is an empty reply.
+type MsgForceCloseChannelResponse struct {
+}
+
+func (m *MsgForceCloseChannelResponse) Reset()         { *m = MsgForceCloseChannelResponse{} }
+func (m *MsgForceCloseChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceCloseChannelResponse) ProtoMessage()    {}
+func (*MsgForceCloseChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78e9bb7be62a4c00, []int{9}
+}
+func (m *MsgForceCloseChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceCloseChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceCloseChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceCloseChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceCloseChannelResponse.Merge(m, src)
+}
+func (m *MsgForceCloseChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceCloseChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceCloseChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceCloseChannelResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSendPacket)(nil), "agoric.vibc.MsgSendPacket")
 	proto.RegisterType((*MsgSendPacketResponse)(nil), "agoric.vibc.MsgSendPacketResponse")
+	proto.RegisterType((*MsgResyncPackets)(nil), "agoric.vibc.MsgResyncPackets")
+	proto.RegisterType((*MsgResyncPacketsResponse)(nil), "agoric.vibc.MsgResyncPacketsResponse")
+	proto.RegisterType((*MsgSetAckDeadline)(nil), "agoric.vibc.MsgSetAckDeadline")
+	proto.RegisterType((*MsgSetAckDeadlineResponse)(nil), "agoric.vibc.MsgSetAckDeadlineResponse")
 	proto.RegisterType((*Packet)(nil), "agoric.vibc.Packet")
 	proto.RegisterType((*Height)(nil), "agoric.vibc.Height")
+	proto.RegisterType((*MsgForceCloseChannel)(nil), "agoric.vibc.MsgForceCloseChannel")
+	proto.RegisterType((*MsgForceCloseChannelResponse)(nil), "agoric.vibc.MsgForceCloseChannelResponse")
 }
 
 func init() { proto.RegisterFile("agoric/vibc/msgs.proto", fileDescriptor_78e9bb7be62a4c00) }
 
 var fileDescriptor_78e9bb7be62a4c00 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xb7, 0xd3, 0xd4, 0xdf, 0xf6, 0xf2, 0xcd, 0x8f, 0xba, 0x40, 0x2d, 0x0f, 0x76, 0x65, 0x09,
-	0xa9, 0x04, 0xd5, 0x16, 0x65, 0x40, 0xca, 0x44, 0xc2, 0xc2, 0xd0, 0xa2, 0x62, 0x98, 0xba, 0x44,
-	0x17, 0xfb, 0xe4, 0x58, 0x8d, 0x7d, 0xc6, 0x77, 0x8e, 0xc8, 0xc6, 0x82, 0x84, 0x60, 0x61, 0x60,
-	0x62, 0xaa, 0x98, 0x18, 0xf3, 0x67, 0x74, 0x2c, 0x1b, 0x93, 0x85, 0x92, 0x21, 0x28, 0x63, 0x47,
-	0x26, 0xe4, 0x3b, 0xbb, 0x75, 0xaa, 0xb2, 0xf8, 0xde, 0x7d, 0x3e, 0xef, 0x3e, 0xf7, 0xde, 0xbb,
-	0xf7, 0x0c, 0xee, 0x41, 0x0f, 0xc7, 0xbe, 0x63, 0x8d, 0xfd, 0x81, 0x63, 0x05, 0xc4, 0x23, 0x66,
-	0x14, 0x63, 0x8a, 0xe5, 0x1a, 0xc7, 0xcd, 0x0c, 0x57, 0xb7, 0x60, 0xe0, 0x87, 0xd8, 0x62, 0x5f,
-	0xce, 0xab, 0x77, 0x3c, 0xec, 0x61, 0x66, 0x5a, 0x99, 0x95, 0xa3, 0x3b, 0x0e, 0x26, 0x01, 0x26,
-	0x99, 0x90, 0x35, 0x7e, 0x94, 0x2d, 0x9c, 0x30, 0xde, 0x57, 0x40, 0xfd, 0x88, 0x78, 0xaf, 0x50,
-	0xe8, 0x1e, 0x43, 0xe7, 0x14, 0x51, 0xf9, 0x10, 0x48, 0x11, 0xb3, 0x14, 0x71, 0x57, 0xdc, 0xab,
-	0x1d, 0x6c, 0x9b, 0xa5, 0x1b, 0x4d, 0xee, 0xd4, 0xd3, 0xcf, 0x53, 0x5d, 0x58, 0xa6, 0x7a, 0xee,
-	0x7a, 0x99, 0xea, 0xf5, 0x09, 0x0c, 0x46, 0x1d, 0x83, 0xef, 0x0d, 0x3b, 0x27, 0xe4, 0x4f, 0x22,
-	0x90, 0x08, 0x0a, 0x5d, 0x14, 0x2b, 0x95, 0x5d, 0x71, 0xef, 0xff, 0x1e, 0x59, 0xa6, 0xfa, 0x26,
-	0x49, 0x06, 0x81, 0x4f, 0x29, 0x8a, 0x2f, 0x53, 0xbd, 0xc5, 0x0f, 0x5e, 0x41, 0xc6, 0x9f, 0x54,
-	0xdf, 0xf7, 0x7c, 0x3a, 0x4c, 0x06, 0xa6, 0x83, 0x03, 0x2b, 0x8f, 0x9c, 0x2f, 0xfb, 0xc4, 0x3d,
-	0xb5, 0xe8, 0x24, 0x42, 0xc4, 0xec, 0x3a, 0x4e, 0xd7, 0x75, 0x63, 0x44, 0xc8, 0xd7, 0xc5, 0xb4,
-	0xdd, 0x18, 0x21, 0x0f, 0x3a, 0x93, 0x3e, 0xe4, 0xd0, 0xb7, 0xc5, 0xb4, 0x7d, 0x7d, 0x8b, 0x9d,
-	0x87, 0xd0, 0x51, 0x7e, 0x9f, 0xe9, 0xc2, 0xc7, 0xc5, 0xb4, 0xdd, 0x64, 0x65, 0xbd, 0xce, 0xda,
-	0xd8, 0x01, 0x77, 0x57, 0xca, 0x60, 0x23, 0x12, 0xe1, 0x90, 0x20, 0xe3, 0x47, 0x05, 0x48, 0x79,
-	0x65, 0x54, 0xb0, 0x41, 0xd0, 0x9b, 0x04, 0x85, 0x0e, 0x62, 0xb5, 0xa9, 0xda, 0x57, 0x7b, 0x59,
-	0x07, 0x35, 0x82, 0x93, 0xd8, 0x41, 0xfd, 0x08, 0xc7, 0x94, 0xe5, 0xba, 0x69, 0x03, 0x0e, 0x1d,
-	0xe3, 0x98, 0xca, 0xf7, 0x41, 0x23, 0x77, 0x70, 0x86, 0x30, 0x0c, 0xd1, 0x48, 0x59, 0x63, 0x3e,
-	0x75, 0x8e, 0x3e, 0xe3, 0xa0, 0xfc, 0x00, 0xb4, 0x5c, 0x44, 0xa8, 0x1f, 0x42, 0xea, 0xe3, 0x90,
-	0x8b, 0x55, 0x99, 0x63, 0xb3, 0x84, 0x33, 0x45, 0x0b, 0x6c, 0x97, 0x5d, 0x0b, 0xd9, 0x75, 0xe6,
-	0x2d, 0x97, 0xa8, 0x42, 0x5b, 0x06, 0x55, 0x17, 0x52, 0xa8, 0x48, 0xd9, 0x43, 0xd8, 0xcc, 0x96,
-	0x9f, 0x82, 0x06, 0xf5, 0x03, 0x84, 0x13, 0xda, 0x1f, 0x22, 0xdf, 0x1b, 0x52, 0xe5, 0xbf, 0x5b,
-	0x5e, 0xfd, 0x39, 0xa3, 0x7a, 0xd5, 0xec, 0xd5, 0xed, 0x7a, 0x7e, 0x80, 0x83, 0xf2, 0x43, 0xb0,
-	0x55, 0x28, 0x64, 0x2b, 0xa1, 0x30, 0x88, 0x94, 0x0d, 0x56, 0x9e, 0x56, 0x4e, 0xbc, 0x2e, 0xf0,
-	0x4e, 0xf5, 0xc3, 0x99, 0x2e, 0x18, 0x5f, 0x44, 0x20, 0xe5, 0xa7, 0xbb, 0xa0, 0x19, 0xa3, 0xb1,
-	0x4f, 0xb2, 0x0c, 0xc2, 0x24, 0x18, 0xa0, 0x98, 0x97, 0xb6, 0xa7, 0x2c, 0x53, 0xfd, 0x26, 0xf5,
-	0x7d, 0x31, 0x6d, 0x8b, 0x76, 0xa3, 0x40, 0x5f, 0x30, 0x70, 0x45, 0x22, 0xcf, 0xa1, 0x72, 0x8b,
-	0x04, 0xa7, 0x6e, 0x48, 0xf0, 0x28, 0x78, 0x58, 0x07, 0x27, 0x60, 0xed, 0x88, 0x78, 0xf2, 0x21,
-	0x00, 0xa5, 0x71, 0x50, 0x57, 0x0a, 0xb1, 0xd2, 0x23, 0xaa, 0xf1, 0x6f, 0xae, 0xe8, 0x1f, 0x75,
-	0xfd, 0x5d, 0x76, 0x63, 0xef, 0xe5, 0xf9, 0x4c, 0x13, 0x2f, 0x66, 0x9a, 0xf8, 0x6b, 0xa6, 0x89,
-	0x9f, 0xe7, 0x9a, 0x70, 0x31, 0xd7, 0x84, 0x9f, 0x73, 0x4d, 0x38, 0x79, 0x52, 0xea, 0xf5, 0x2e,
-	0x9f, 0x79, 0xae, 0xca, 0x7a, 0xdd, 0xc3, 0x23, 0x18, 0x7a, 0xc5, 0x10, 0xbc, 0xe5, 0xbf, 0x03,
-	0x36, 0x00, 0x03, 0x89, 0x4d, 0xf0, 0xe3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x67, 0x21,
-	0x23, 0x2a, 0x04, 0x00, 0x00,
+	// 869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0xd3, 0xd4, 0xdd, 0xbc, 0x25, 0xd9, 0x64, 0x76, 0xd9, 0x75, 0x0d, 0xb5, 0x8b, 0xa5,
+	0xad, 0xba, 0x41, 0x9b, 0x88, 0x22, 0x15, 0x29, 0x27, 0x12, 0x10, 0xa2, 0x52, 0x83, 0x8a, 0x0b,
+	0x12, 0xe2, 0x12, 0x39, 0xf6, 0xc8, 0xb1, 0x12, 0x7b, 0x82, 0x67, 0xb2, 0x22, 0x37, 0x84, 0x84,
+	0x84, 0xe8, 0x85, 0x03, 0x27, 0x4e, 0x15, 0x27, 0xb8, 0x05, 0x89, 0x3f, 0xa2, 0xc7, 0xc2, 0x89,
+	0x53, 0x84, 0x76, 0x0f, 0x41, 0x7b, 0xe0, 0xd0, 0x23, 0x27, 0xe4, 0x99, 0xb1, 0xd7, 0x49, 0x96,
+	0x22, 0x71, 0xe8, 0x25, 0x99, 0xf9, 0xbe, 0x37, 0x9f, 0xbf, 0xf7, 0xe6, 0xc7, 0x83, 0x7d, 0xc7,
+	0x27, 0x71, 0xe0, 0xb6, 0x4e, 0x82, 0x81, 0xdb, 0x0a, 0xa9, 0x4f, 0x9b, 0x93, 0x98, 0x30, 0x82,
+	0xb6, 0x05, 0xde, 0x4c, 0x70, 0xbd, 0xee, 0x84, 0x41, 0x44, 0x5a, 0xfc, 0x57, 0xf0, 0xfa, 0x9e,
+	0x4f, 0x7c, 0xc2, 0x87, 0xad, 0x64, 0x24, 0xd1, 0x03, 0x97, 0xd0, 0x90, 0xd0, 0x44, 0xa8, 0x75,
+	0xf2, 0x46, 0xf2, 0x27, 0x89, 0xeb, 0x82, 0xe8, 0x8b, 0x15, 0x62, 0x22, 0x28, 0xeb, 0xab, 0x22,
+	0x54, 0x7a, 0xd4, 0x7f, 0x88, 0x23, 0xef, 0x81, 0xe3, 0x8e, 0x30, 0x43, 0xf7, 0x41, 0x9d, 0xf0,
+	0x91, 0xa6, 0xdc, 0x54, 0x6e, 0x6f, 0xdf, 0xd9, 0x6d, 0xe6, 0xcc, 0x34, 0x45, 0x50, 0xd7, 0x7c,
+	0xb2, 0x30, 0x0b, 0xe7, 0x0b, 0x53, 0x86, 0x3e, 0x5b, 0x98, 0x95, 0x99, 0x13, 0x8e, 0xdb, 0x96,
+	0x98, 0x5b, 0xb6, 0x24, 0xd0, 0x23, 0x05, 0x54, 0x8a, 0x23, 0x0f, 0xc7, 0x5a, 0xf1, 0xa6, 0x72,
+	0xfb, 0xa5, 0x2e, 0x3d, 0x5f, 0x98, 0x65, 0x3a, 0x1d, 0x84, 0x01, 0x63, 0x38, 0x7e, 0xb6, 0x30,
+	0x6b, 0x62, 0x61, 0x06, 0x59, 0x7f, 0x2f, 0xcc, 0x63, 0x3f, 0x60, 0xc3, 0xe9, 0xa0, 0xe9, 0x92,
+	0x50, 0xda, 0x95, 0x7f, 0xc7, 0xd4, 0x1b, 0xb5, 0xd8, 0x6c, 0x82, 0x69, 0xb3, 0xe3, 0xba, 0x1d,
+	0xcf, 0x8b, 0x31, 0xa5, 0xdf, 0x2f, 0xe7, 0x8d, 0xea, 0x18, 0xfb, 0x8e, 0x3b, 0xeb, 0x3b, 0x02,
+	0xfa, 0x61, 0x39, 0x6f, 0x5c, 0x7c, 0xc5, 0x96, 0x16, 0xda, 0xda, 0x9f, 0x8f, 0xcd, 0xc2, 0x37,
+	0xcb, 0x79, 0x63, 0x87, 0x57, 0xfc, 0x22, 0x6b, 0xeb, 0x00, 0x5e, 0x5e, 0x29, 0x83, 0x8d, 0xe9,
+	0x84, 0x44, 0x14, 0x5b, 0x3f, 0x29, 0x50, 0xeb, 0x51, 0xdf, 0xc6, 0x74, 0x16, 0xb9, 0x82, 0xa3,
+	0xe8, 0x2e, 0x94, 0x9d, 0x29, 0x1b, 0x92, 0x38, 0x60, 0x33, 0x5e, 0xa6, 0x72, 0x57, 0xfb, 0xed,
+	0x97, 0xe3, 0x3d, 0x59, 0x5a, 0xe9, 0xeb, 0x21, 0x8b, 0x83, 0xc8, 0xb7, 0x2f, 0x42, 0xd1, 0x01,
+	0x5c, 0x9b, 0x90, 0x98, 0xf5, 0x03, 0x8f, 0x57, 0xa3, 0x6c, 0xab, 0xc9, 0xf4, 0x9e, 0x87, 0x6e,
+	0x00, 0xb8, 0x43, 0x27, 0x8a, 0xf0, 0x38, 0xe1, 0xae, 0x70, 0xae, 0x2c, 0x91, 0x7b, 0x5e, 0xfb,
+	0xf0, 0xcb, 0x24, 0x9f, 0x4c, 0x27, 0xc9, 0x00, 0xf1, 0x0c, 0x56, 0x6c, 0x59, 0x77, 0x41, 0x5b,
+	0xb7, 0x9a, 0xe6, 0x81, 0x74, 0xd8, 0x8a, 0x39, 0x81, 0x3d, 0xee, 0xb8, 0x64, 0x67, 0x73, 0xeb,
+	0x91, 0x02, 0x75, 0x9e, 0x3d, 0xeb, 0xb8, 0xa3, 0x77, 0xb1, 0xe3, 0x8d, 0x83, 0x08, 0xff, 0xef,
+	0x24, 0xf7, 0x41, 0x1d, 0x8c, 0x89, 0x3b, 0xa2, 0x3c, 0xc7, 0x92, 0x2d, 0x67, 0xed, 0x5b, 0x9b,
+	0x49, 0xec, 0xca, 0x6d, 0xc8, 0x7f, 0xd7, 0x7a, 0x05, 0xae, 0x6f, 0x98, 0xc9, 0xb6, 0xe3, 0xd7,
+	0x22, 0xa8, 0xf2, 0xa0, 0xea, 0xb0, 0x45, 0xf1, 0x67, 0x53, 0x1c, 0xb9, 0x38, 0xcd, 0x28, 0x9d,
+	0x23, 0x13, 0xb6, 0x29, 0x99, 0xc6, 0x2e, 0xee, 0x27, 0x05, 0x96, 0xc5, 0x06, 0x01, 0x3d, 0x20,
+	0x31, 0x43, 0x87, 0x50, 0x95, 0x01, 0xb2, 0xca, 0xb2, 0xe8, 0x15, 0x81, 0xbe, 0x23, 0x40, 0x74,
+	0x04, 0x35, 0x0f, 0x53, 0x16, 0x44, 0x0e, 0x0b, 0x48, 0x24, 0xc4, 0x4a, 0x3c, 0x70, 0x27, 0x87,
+	0x73, 0xc5, 0x16, 0xec, 0xe6, 0x43, 0x53, 0xd9, 0xab, 0x3c, 0x1a, 0xe5, 0xa8, 0x54, 0x1b, 0x41,
+	0xc9, 0x73, 0x98, 0xa3, 0xa9, 0xc9, 0xbd, 0xb0, 0xf9, 0x18, 0xbd, 0x0d, 0x55, 0x16, 0x84, 0x98,
+	0x4c, 0x59, 0x7f, 0x88, 0x03, 0x7f, 0xc8, 0xb4, 0x6b, 0x97, 0x5c, 0xc2, 0xf7, 0x39, 0xd5, 0x2d,
+	0x25, 0x97, 0xd0, 0xae, 0xc8, 0x05, 0x02, 0x44, 0xaf, 0x43, 0x3d, 0x55, 0x48, 0xfe, 0x29, 0x73,
+	0xc2, 0x89, 0xb6, 0xc5, 0xcb, 0x53, 0x93, 0xc4, 0x47, 0x29, 0xde, 0x2e, 0x7d, 0xfd, 0xd8, 0x2c,
+	0x58, 0xdf, 0x29, 0xa0, 0xca, 0xd5, 0x1d, 0xd8, 0x89, 0xf1, 0x49, 0x40, 0x93, 0x0c, 0xa2, 0x69,
+	0x38, 0xc0, 0xb1, 0x28, 0x6d, 0x57, 0x3b, 0x5f, 0x98, 0xeb, 0xd4, 0x8f, 0xcb, 0x79, 0x43, 0xb1,
+	0xab, 0x29, 0xfa, 0x01, 0x07, 0x57, 0x24, 0x64, 0x0e, 0xc5, 0x4b, 0x24, 0x04, 0xb5, 0x26, 0x21,
+	0x5c, 0x48, 0x5b, 0x3f, 0x2b, 0xb0, 0xd7, 0xa3, 0xfe, 0x7b, 0x24, 0xd9, 0x8f, 0x31, 0xa1, 0xd9,
+	0xa6, 0xbc, 0xe8, 0xdb, 0x77, 0xb4, 0x79, 0x70, 0xf7, 0xf9, 0xc1, 0xdd, 0xb0, 0x66, 0x19, 0xf0,
+	0xea, 0x65, 0x96, 0xd3, 0xe3, 0x7b, 0xe7, 0xaf, 0x22, 0x5c, 0xe9, 0x51, 0x1f, 0xdd, 0x07, 0xc8,
+	0x3d, 0xb9, 0xfa, 0xca, 0xee, 0xae, 0xbc, 0x43, 0xba, 0xf5, 0xef, 0x5c, 0x76, 0xb7, 0x3f, 0x86,
+	0xca, 0xea, 0xfb, 0x74, 0x63, 0x7d, 0xd1, 0x0a, 0xad, 0x1f, 0x3e, 0x97, 0xce, 0x64, 0x3f, 0x81,
+	0xea, 0xda, 0x93, 0x60, 0x6c, 0x9a, 0xc9, 0xf3, 0xfa, 0xad, 0xe7, 0xf3, 0x99, 0xb2, 0x03, 0xf5,
+	0xcd, 0x6d, 0x7d, 0x6d, 0x7d, 0xf1, 0x46, 0x88, 0x7e, 0xf4, 0x9f, 0x21, 0xe9, 0x27, 0xf4, 0xab,
+	0x5f, 0x24, 0x47, 0xab, 0xfb, 0xe1, 0x93, 0x53, 0x43, 0x79, 0x7a, 0x6a, 0x28, 0x7f, 0x9c, 0x1a,
+	0xca, 0xb7, 0x67, 0x46, 0xe1, 0xe9, 0x99, 0x51, 0xf8, 0xfd, 0xcc, 0x28, 0x7c, 0xfa, 0x56, 0xae,
+	0xc7, 0x74, 0x44, 0x1b, 0x16, 0xe2, 0xbc, 0xc7, 0xf8, 0x64, 0xec, 0x44, 0x7e, 0xda, 0x7c, 0x3e,
+	0x17, 0x1d, 0x9a, 0x37, 0x9e, 0x81, 0xca, 0x3b, 0xe7, 0x9b, 0xff, 0x0c, 0x00, 0xd5, 0x12, 0x3f,
+	0xb3, 0xbd, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -290,6 +618,13 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Force sending an arbitrary packet on a channel.
 	SendPacket(ctx context.Context, in *MsgSendPacket, opts ...grpc.CallOption) (*MsgSendPacketResponse, error)
+	// Re-emit to the VM the packets that it has sent but that await
+	// acknowledgement or timeout.
+	ResyncPackets(ctx context.Context, in *MsgResyncPackets, opts ...grpc.CallOption) (*MsgResyncPacketsResponse, error)
+	// Set the acknowledgement deadline of packets subsequently received.
+	SetAckDeadline(ctx context.Context, in *MsgSetAckDeadline, opts ...grpc.CallOption) (*MsgSetAckDeadlineResponse, error)
+	// Close a jammed ordered channel of a VM-owned port.
+	ForceCloseChannel(ctx context.Context, in *MsgForceCloseChannel, opts ...grpc.CallOption) (*MsgForceCloseChannelResponse, error)
 }
 
 type msgClient struct {
@@ -309,10 +644,44 @@ func (c *msgClient) SendPacket(ctx context.Context, in *MsgSendPacket, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ResyncPackets(ctx context.Context, in *MsgResyncPackets, opts ...grpc.CallOption) (*MsgResyncPacketsResponse, error) {
+	out := new(MsgResyncPacketsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vibc.Msg/ResyncPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAckDeadline(ctx context.Context, in *MsgSetAckDeadline, opts ...grpc.CallOption) (*MsgSetAckDeadlineResponse, error) {
+	out := new(MsgSetAckDeadlineResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vibc.Msg/SetAckDeadline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceCloseChannel(ctx context.Context, in *MsgForceCloseChannel, opts ...grpc.CallOption) (*MsgForceCloseChannelResponse, error) {
+	out := new(MsgForceCloseChannelResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vibc.Msg/ForceCloseChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Force sending an arbitrary packet on a channel.
 	SendPacket(context.Context, *MsgSendPacket) (*MsgSendPacketResponse, error)
+	// Re-emit to the VM the packets that it has sent but that await
+	// acknowledgement or timeout.
+	ResyncPackets(context.Context, *MsgResyncPackets) (*MsgResyncPacketsResponse, error)
+	// Set the acknowledgement deadline of packets subsequently received.
+	SetAckDeadline(context.Context, *MsgSetAckDeadline) (*MsgSetAckDeadlineResponse, error)
+	// Close a jammed ordered channel of a VM-owned port.
+	ForceCloseChannel(context.Context, *MsgForceCloseChannel) (*MsgForceCloseChannelResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -322,6 +691,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SendPacket(ctx context.Context, req *MsgSendPacket) (*MsgSendPacketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendPacket not implemented")
 }
+func (*UnimplementedMsgServer) ResyncPackets(ctx context.Context, req *MsgResyncPackets) (*MsgResyncPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResyncPackets not implemented")
+}
+func (*UnimplementedMsgServer) SetAckDeadline(ctx context.Context, req *MsgSetAckDeadline) (*MsgSetAckDeadlineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAckDeadline not implemented")
+}
+func (*UnimplementedMsgServer) ForceCloseChannel(ctx context.Context, req *MsgForceCloseChannel) (*MsgForceCloseChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceCloseChannel not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -345,6 +723,60 @@ func _Msg_SendPacket_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResyncPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResyncPackets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResyncPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vibc.Msg/ResyncPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResyncPackets(ctx, req.(*MsgResyncPackets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAckDeadline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAckDeadline)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAckDeadline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vibc.Msg/SetAckDeadline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAckDeadline(ctx, req.(*MsgSetAckDeadline))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceCloseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceCloseChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceCloseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vibc.Msg/ForceCloseChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceCloseChannel(ctx, req.(*MsgForceCloseChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "agoric.vibc.Msg",
@@ -354,6 +786,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendPacket",
 			Handler:    _Msg_SendPacket_Handler,
 		},
+		{
+			MethodName: "ResyncPackets",
+			Handler:    _Msg_ResyncPackets_Handler,
+		},
+		{
+			MethodName: "SetAckDeadline",
+			Handler:    _Msg_SetAckDeadline_Handler,
+		},
+		{
+			MethodName: "ForceCloseChannel",
+			Handler:    _Msg_ForceCloseChannel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agoric/vibc/msgs.proto",
@@ -422,7 +866,7 @@ func (m *MsgSendPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Packet) Marshal() (dAtA []byte, err error) {
+func (m *MsgResyncPackets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -432,39 +876,169 @@ func (m *Packet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Packet) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgResyncPackets) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgResyncPackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimeoutTimestamp != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.TimeoutTimestamp))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChannelId)))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x1a
 	}
-	{
-		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Data)))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0xa
 	}
-	if len(m.DestinationChannel) > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResyncPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResyncPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResyncPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resynced != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Resynced))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAckDeadline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAckDeadline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAckDeadline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAckDeadlineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAckDeadlineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAckDeadlineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Packet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Packet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DestinationChannel) > 0 {
 		i -= len(m.DestinationChannel)
 		copy(dAtA[i:], m.DestinationChannel)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DestinationChannel)))
@@ -533,6 +1107,73 @@ func (m *Height) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgForceCloseChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceCloseChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceCloseChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceCloseChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceCloseChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceCloseChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -568,6 +1209,64 @@ func (m *MsgSendPacketResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgResyncPackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResyncPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resynced != 0 {
+		n += 1 + sovMsgs(uint64(m.Resynced))
+	}
+	return n
+}
+
+func (m *MsgSetAckDeadline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovMsgs(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *MsgSetAckDeadlineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *Packet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -602,31 +1301,443 @@ func (m *Packet) Size() (n int) {
 	if m.TimeoutTimestamp != 0 {
 		n += 1 + sovMsgs(uint64(m.TimeoutTimestamp))
 	}
-	return n
-}
+	return n
+}
+
+func (m *Height) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RevisionNumber != 0 {
+		n += 1 + sovMsgs(uint64(m.RevisionNumber))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.RevisionHeight))
+	}
+	return n
+}
+
+func (m *MsgForceCloseChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceCloseChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResyncPackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResyncPackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResyncPackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResyncPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResyncPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResyncPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resynced", wireType)
+			}
+			m.Resynced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resynced |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Height) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RevisionNumber != 0 {
-		n += 1 + sovMsgs(uint64(m.RevisionNumber))
-	}
-	if m.RevisionHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.RevisionHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAckDeadline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -649,17 +1760,17 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAckDeadline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAckDeadline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -669,30 +1780,29 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var byteLen int
+			m.Blocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -702,26 +1812,11 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Blocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -743,7 +1838,7 @@ func (m *MsgSendPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAckDeadlineResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -766,10 +1861,10 @@ func (m *MsgSendPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAckDeadlineResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAckDeadlineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1164,6 +2259,202 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgForceCloseChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceCloseChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceCloseChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceCloseChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceCloseChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceCloseChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	agtypes "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+func init() {
+	vm.RegisterActions(&PacketInFlightEvent{})
+}
+
+// PacketInFlightEvent tells the VM of a packet it sent that still awaits
+// acknowledgement or timeout.  A resync pushes one for each such packet, so
+// the VM can recover from having missed the event that settled another.
+type PacketInFlightEvent struct {
+	*vm.ActionHeader `actionType:"IBC_EVENT"`
+	Event            string            `json:"event" default:"packetInFlight"`
+	Target           string            `json:"target,omitempty"`
+	Packet           agtypes.IBCPacket `json:"packet"`
+	SendHeight       int64             `json:"sendHeight"`
+}
+
+// NewPacketInFlightEvent returns the event for an in-flight packet.
+func NewPacketInFlightEvent(inFlight InFlightPacket) PacketInFlightEvent {
+	return PacketInFlightEvent{
+		Target:     inFlight.Target,
+		Packet:     agtypes.CopyToIBCPacket(inFlight.Packet),
+		SendHeight: inFlight.SendHeight,
+	}
+}
+
+// ValidatePacketFilter checks a restriction of in-flight packets to those of
+// a port, or of a channel of the port.  Both may be empty to select every
+// packet.
+func ValidatePacketFilter(portID, channelID string) error {
+	if len(portID) == 0 {
+		if len(channelID) > 0 {
+			return fmt.Errorf("channel %s requires a port", channelID)
+		}
+		return nil
+	}
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return err
+	}
+	if len(channelID) > 0 {
+		return host.ChannelIdentifierValidator(channelID)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+// DefaultParams returns default vibc parameters, in which acknowledgements
+// have no deadline.
+func DefaultParams() Params {
+	return Params{
+		AckDeadlineBlocks: 0,
+	}
+}
+
+// AckDeadlineHeight returns the height by which a packet received at height
+// must be acknowledged, or zero if there is no deadline.
+func (p Params) AckDeadlineHeight(height int64) int64 {
+	if p.AckDeadlineBlocks == 0 {
+		return 0
+	}
+	return height + int64(p.AckDeadlineBlocks)
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"bytes"
+)
+
+// legacyBoundPortValue is the value stored for ports bound before their bind
+// heights were recorded.
+var legacyBoundPortValue = []byte{1}
+
+// UnmarshalBoundPort decodes the stored binding of portID, which may predate
+// the recording of bind heights.
+func UnmarshalBoundPort(portID string, bz []byte) (BoundPort, error) {
+	if bytes.Equal(bz, legacyBoundPortValue) {
+		return BoundPort{PortId: portID}, nil
+	}
+	var binding BoundPort
+	if err := binding.Unmarshal(bz); err != nil {
+		return binding, err
+	}
+	return binding, nil
+}
@@ -0,0 +1,2390 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: agoric/vibc/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryInFlightPacketsRequest is the request type for the Query/InFlightPackets
+// RPC method.
+type QueryInFlightPacketsRequest struct {
+	// port_id, if nonempty, restricts the packets to those sent from the port.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id, if nonempty, restricts the packets to those sent on the
+	// channel of port_id.
+	ChannelId  string             `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInFlightPacketsRequest) Reset()         { *m = QueryInFlightPacketsRequest{} }
+func (m *QueryInFlightPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketsRequest) ProtoMessage()    {}
+func (*QueryInFlightPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{2}
+}
+func (m *QueryInFlightPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInFlightPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketsRequest.Merge(m, src)
+}
+func (m *QueryInFlightPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInFlightPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketsRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryInFlightPacketsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryInFlightPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInFlightPacketsResponse is the response type for the
+// Query/InFlightPackets RPC method.
+type QueryInFlightPacketsResponse struct {
+	Packets    []InFlightPacket    `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInFlightPacketsResponse) Reset()         { *m = QueryInFlightPacketsResponse{} }
+func (m *QueryInFlightPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketsResponse) ProtoMessage()    {}
+func (*QueryInFlightPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{3}
+}
+func (m *QueryInFlightPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInFlightPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketsResponse.Merge(m, src)
+}
+func (m *QueryInFlightPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInFlightPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketsResponse) GetPackets() []InFlightPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryInFlightPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBoundPortsRequest is the request type for the Query/BoundPorts RPC
+// method.
+type QueryBoundPortsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBoundPortsRequest) Reset()         { *m = QueryBoundPortsRequest{} }
+func (m *QueryBoundPortsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBoundPortsRequest) ProtoMessage()    {}
+func (*QueryBoundPortsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{4}
+}
+func (m *QueryBoundPortsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoundPortsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoundPortsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoundPortsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoundPortsRequest.Merge(m, src)
+}
+func (m *QueryBoundPortsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoundPortsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoundPortsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoundPortsRequest proto.InternalMessageInfo
+
+func (m *QueryBoundPortsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBoundPortsResponse is the response type for the Query/BoundPorts RPC
+// method.
+type QueryBoundPortsResponse struct {
+	Ports      []BoundPort         `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBoundPortsResponse) Reset()         { *m = QueryBoundPortsResponse{} }
+func (m *QueryBoundPortsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBoundPortsResponse) ProtoMessage()    {}
+func (*QueryBoundPortsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{5}
+}
+func (m *QueryBoundPortsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoundPortsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoundPortsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoundPortsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoundPortsResponse.Merge(m, src)
+}
+func (m *QueryBoundPortsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoundPortsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoundPortsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoundPortsResponse proto.InternalMessageInfo
+
+func (m *QueryBoundPortsResponse) GetPorts() []BoundPort {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *QueryBoundPortsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOverdueAcksRequest is the request type for the Query/OverdueAcks RPC
+// method.
+type QueryOverdueAcksRequest struct {
+}
+
+func (m *QueryOverdueAcksRequest) Reset()         { *m = QueryOverdueAcksRequest{} }
+func (m *QueryOverdueAcksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOverdueAcksRequest) ProtoMessage()    {}
+func (*QueryOverdueAcksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{6}
+}
+func (m *QueryOverdueAcksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOverdueAcksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOverdueAcksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOverdueAcksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOverdueAcksRequest.Merge(m, src)
+}
+func (m *QueryOverdueAcksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOverdueAcksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOverdueAcksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOverdueAcksRequest proto.InternalMessageInfo
+
+// QueryOverdueAcksResponse is the response type for the Query/OverdueAcks RPC
+// method.
+type QueryOverdueAcksResponse struct {
+	// acks are ordered by deadline.
+	Acks []PendingAck `protobuf:"bytes,1,rep,name=acks,proto3" json:"acks"`
+}
+
+func (m *QueryOverdueAcksResponse) Reset()         { *m = QueryOverdueAcksResponse{} }
+func (m *QueryOverdueAcksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOverdueAcksResponse) ProtoMessage()    {}
+func (*QueryOverdueAcksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{7}
+}
+func (m *QueryOverdueAcksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOverdueAcksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOverdueAcksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOverdueAcksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOverdueAcksResponse.Merge(m, src)
+}
+func (m *QueryOverdueAcksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOverdueAcksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOverdueAcksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOverdueAcksResponse proto.InternalMessageInfo
+
+func (m *QueryOverdueAcksResponse) GetAcks() []PendingAck {
+	if m != nil {
+		return m.Acks
+	}
+	return nil
+}
+
+// QueryChannelDiagnosticsRequest is the request type for the
+// Query/ChannelDiagnostics RPC method.
+type QueryChannelDiagnosticsRequest struct {
+	// port_id is the port whose channels to diagnose.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id, if nonempty, restricts the channels to the one of port_id.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelDiagnosticsRequest) Reset()         { *m = QueryChannelDiagnosticsRequest{} }
+func (m *QueryChannelDiagnosticsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelDiagnosticsRequest) ProtoMessage()    {}
+func (*QueryChannelDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{8}
+}
+func (m *QueryChannelDiagnosticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelDiagnosticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelDiagnosticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelDiagnosticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelDiagnosticsRequest.Merge(m, src)
+}
+func (m *QueryChannelDiagnosticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelDiagnosticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelDiagnosticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelDiagnosticsRequest proto.InternalMessageInfo
+
+func (m *QueryChannelDiagnosticsRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryChannelDiagnosticsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryChannelDiagnosticsResponse is the response type for the
+// Query/ChannelDiagnostics RPC method.
+type QueryChannelDiagnosticsResponse struct {
+	Channels []ChannelDiagnostic `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *QueryChannelDiagnosticsResponse) Reset()         { *m = QueryChannelDiagnosticsResponse{} }
+func (m *QueryChannelDiagnosticsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelDiagnosticsResponse) ProtoMessage()    {}
+func (*QueryChannelDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071d64a2400a7606, []int{9}
+}
+func (m *QueryChannelDiagnosticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelDiagnosticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelDiagnosticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelDiagnosticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelDiagnosticsResponse.Merge(m, src)
+}
+func (m *QueryChannelDiagnosticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelDiagnosticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelDiagnosticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelDiagnosticsResponse proto.InternalMessageInfo
+
+func (m *QueryChannelDiagnosticsResponse) GetChannels() []ChannelDiagnostic {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "agoric.vibc.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "agoric.vibc.QueryParamsResponse")
+	proto.RegisterType((*QueryInFlightPacketsRequest)(nil), "agoric.vibc.QueryInFlightPacketsRequest")
+	proto.RegisterType((*QueryInFlightPacketsResponse)(nil), "agoric.vibc.QueryInFlightPacketsResponse")
+	proto.RegisterType((*QueryBoundPortsRequest)(nil), "agoric.vibc.QueryBoundPortsRequest")
+	proto.RegisterType((*QueryBoundPortsResponse)(nil), "agoric.vibc.QueryBoundPortsResponse")
+	proto.RegisterType((*QueryOverdueAcksRequest)(nil), "agoric.vibc.QueryOverdueAcksRequest")
+	proto.RegisterType((*QueryOverdueAcksResponse)(nil), "agoric.vibc.QueryOverdueAcksResponse")
+	proto.RegisterType((*QueryChannelDiagnosticsRequest)(nil), "agoric.vibc.QueryChannelDiagnosticsRequest")
+	proto.RegisterType((*QueryChannelDiagnosticsResponse)(nil), "agoric.vibc.QueryChannelDiagnosticsResponse")
+}
+
+func init() { proto.RegisterFile("agoric/vibc/query.proto", fileDescriptor_071d64a2400a7606) }
+
+var fileDescriptor_071d64a2400a7606 = []byte{
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0xfe, 0x48, 0xe9, 0xcb, 0x80, 0x74, 0x2d, 0x4d, 0xea, 0x14, 0x37, 0x18, 0x28,
+	0xe1, 0x97, 0xad, 0x86, 0x81, 0x81, 0x85, 0x16, 0x54, 0xe8, 0x80, 0x48, 0x33, 0x21, 0x96, 0x70,
+	0xb1, 0x8f, 0x8b, 0x95, 0xf4, 0xce, 0xb5, 0x9d, 0x8a, 0x0e, 0x2c, 0xfc, 0x01, 0x08, 0x09, 0x09,
+	0xc1, 0xc0, 0xc6, 0x1f, 0xd3, 0xb1, 0x12, 0x0b, 0x13, 0x42, 0x2d, 0x7f, 0x08, 0xf2, 0xdd, 0x39,
+	0xc9, 0xe1, 0x96, 0x56, 0xc0, 0x12, 0x25, 0x77, 0xdf, 0xf7, 0xde, 0xe7, 0xbd, 0x7c, 0x9f, 0x0d,
+	0x65, 0x4c, 0x79, 0x14, 0x78, 0xee, 0x6e, 0xd0, 0xf1, 0xdc, 0x9d, 0x01, 0x89, 0xf6, 0x9c, 0x30,
+	0xe2, 0x09, 0x47, 0x25, 0x79, 0xe1, 0xa4, 0x17, 0xe6, 0x3c, 0xe5, 0x94, 0x8b, 0x73, 0x37, 0xfd,
+	0x26, 0x25, 0xe6, 0x12, 0xe5, 0x9c, 0xf6, 0x89, 0x8b, 0xc3, 0xc0, 0xc5, 0x8c, 0xf1, 0x04, 0x27,
+	0x01, 0x67, 0xb1, 0xba, 0xbd, 0xe1, 0xf1, 0x78, 0x9b, 0xc7, 0x6e, 0x07, 0xc7, 0x44, 0x66, 0x76,
+	0x77, 0x57, 0x3b, 0x24, 0xc1, 0xab, 0x6e, 0x88, 0x69, 0xc0, 0x84, 0x58, 0x69, 0x17, 0xc6, 0x29,
+	0xd2, 0x0f, 0x79, 0x6e, 0xcf, 0x03, 0xda, 0x4a, 0x23, 0x9b, 0x38, 0xc2, 0xdb, 0x71, 0x8b, 0xec,
+	0x0c, 0x48, 0x9c, 0xd8, 0x8f, 0x61, 0x4e, 0x3b, 0x8d, 0x43, 0xce, 0x62, 0x82, 0x56, 0xa1, 0x18,
+	0x8a, 0x93, 0x8a, 0x51, 0x33, 0xea, 0xa5, 0xc6, 0x9c, 0x33, 0xd6, 0x82, 0x23, 0xc5, 0xeb, 0x53,
+	0xfb, 0xdf, 0x97, 0x0b, 0x2d, 0x25, 0xb4, 0x3f, 0x1b, 0x50, 0x15, 0xa9, 0x36, 0xd9, 0x46, 0x3f,
+	0xa0, 0xdd, 0xa4, 0x89, 0xbd, 0x1e, 0x49, 0xb2, 0x4a, 0xa8, 0x0c, 0x33, 0x21, 0x8f, 0x92, 0x76,
+	0xe0, 0x8b, 0x9c, 0xb3, 0xad, 0x62, 0xfa, 0x73, 0xd3, 0x47, 0x17, 0x01, 0xbc, 0x2e, 0x66, 0x8c,
+	0xf4, 0xd3, 0xbb, 0x09, 0x71, 0x37, 0xab, 0x4e, 0x36, 0x7d, 0xb4, 0x01, 0x30, 0xea, 0xb1, 0x32,
+	0x29, 0x70, 0x56, 0x1c, 0x39, 0x10, 0x27, 0x1d, 0x88, 0x23, 0x47, 0xad, 0x06, 0xe2, 0x34, 0x31,
+	0x25, 0xaa, 0x66, 0x6b, 0x2c, 0xd2, 0xfe, 0x62, 0xc0, 0xd2, 0xf1, 0x7c, 0xaa, 0xe7, 0x7b, 0x30,
+	0x13, 0xca, 0xa3, 0x8a, 0x51, 0x9b, 0xac, 0x97, 0x1a, 0x55, 0xad, 0x69, 0x3d, 0x4c, 0x35, 0x9f,
+	0x45, 0xa0, 0x47, 0x1a, 0xe5, 0x84, 0xa0, 0xbc, 0x76, 0x2a, 0xa5, 0xac, 0xac, 0x61, 0xbe, 0x80,
+	0x05, 0x41, 0xb9, 0xce, 0x07, 0xcc, 0x6f, 0xf2, 0x68, 0x34, 0x40, 0x7d, 0x10, 0xc6, 0x5f, 0x0f,
+	0xe2, 0x83, 0x01, 0xe5, 0x5c, 0x09, 0x35, 0x83, 0x06, 0x4c, 0xa7, 0xff, 0x4a, 0x36, 0x81, 0x05,
+	0x6d, 0x02, 0x43, 0xbd, 0x6a, 0x5e, 0x4a, 0xff, 0x5f, 0xeb, 0x8b, 0x8a, 0xeb, 0xe9, 0x2e, 0x89,
+	0xfc, 0x01, 0x59, 0xf3, 0x7a, 0x43, 0x9b, 0x3e, 0x81, 0x4a, 0xfe, 0x6a, 0xe8, 0xd5, 0x29, 0xec,
+	0xf5, 0x32, 0xe4, 0xb2, 0xee, 0x54, 0xc2, 0xfc, 0x80, 0xd1, 0x35, 0xaf, 0xa7, 0x98, 0x85, 0xd4,
+	0x7e, 0x06, 0x96, 0x48, 0xf7, 0x40, 0xba, 0xec, 0x61, 0x80, 0x29, 0xe3, 0x71, 0x12, 0x78, 0xff,
+	0xea, 0x56, 0xdb, 0x83, 0xe5, 0x13, 0x33, 0x2b, 0xde, 0xfb, 0x70, 0x4e, 0xe9, 0x33, 0x66, 0x4b,
+	0x63, 0xce, 0x85, 0x2a, 0xf4, 0x61, 0x54, 0xe3, 0xe3, 0x34, 0x4c, 0x8b, 0x2a, 0xa8, 0x0b, 0x45,
+	0xb9, 0x8c, 0x68, 0x59, 0xcb, 0x91, 0xdf, 0x74, 0xb3, 0x76, 0xb2, 0x40, 0x82, 0xd9, 0xd5, 0x37,
+	0x5f, 0x7f, 0xbe, 0x9f, 0xb8, 0x80, 0xe6, 0xdc, 0xf1, 0x47, 0x88, 0x5c, 0x6f, 0xf4, 0xd6, 0x80,
+	0xf3, 0xbf, 0x6d, 0x0e, 0xaa, 0xe7, 0x53, 0x1e, 0xbf, 0xfc, 0xe6, 0xf5, 0x33, 0x28, 0x15, 0xc5,
+	0x8a, 0xa0, 0xa8, 0x21, 0x4b, 0xa3, 0x08, 0x58, 0xfb, 0xa5, 0x90, 0xb7, 0xb3, 0x8d, 0xdb, 0x03,
+	0x18, 0x19, 0x18, 0x5d, 0xce, 0x17, 0xc8, 0x6d, 0x90, 0x79, 0xe5, 0xcf, 0x22, 0x05, 0x50, 0x13,
+	0x00, 0x26, 0xaa, 0x68, 0x00, 0x9d, 0x54, 0xd8, 0x96, 0x8e, 0x7f, 0x0d, 0xa5, 0x31, 0x23, 0xa2,
+	0x63, 0xd2, 0xe6, 0x2d, 0x6c, 0x5e, 0x3d, 0x45, 0xa5, 0xaa, 0x5f, 0x12, 0xd5, 0xab, 0x68, 0x51,
+	0xab, 0xce, 0xa5, 0xb2, 0x9d, 0xba, 0x17, 0x7d, 0x32, 0x00, 0xe5, 0xfd, 0x85, 0x6e, 0xe6, 0x0b,
+	0x9c, 0xe8, 0x6f, 0xf3, 0xd6, 0xd9, 0xc4, 0x0a, 0xaa, 0x2e, 0xa0, 0x6c, 0x54, 0xd3, 0xa0, 0xb2,
+	0x3d, 0xf0, 0x47, 0x11, 0xeb, 0x5b, 0xfb, 0x87, 0x96, 0x71, 0x70, 0x68, 0x19, 0x3f, 0x0e, 0x2d,
+	0xe3, 0xdd, 0x91, 0x55, 0x38, 0x38, 0xb2, 0x0a, 0xdf, 0x8e, 0xac, 0xc2, 0xf3, 0xbb, 0x34, 0x48,
+	0xba, 0x83, 0x8e, 0xe3, 0xf1, 0x6d, 0x77, 0x4d, 0x66, 0x91, 0xc9, 0x6e, 0xc7, 0x7e, 0xcf, 0xa5,
+	0xbc, 0x8f, 0x19, 0x75, 0xd5, 0x7b, 0xee, 0x95, 0x2c, 0x90, 0xec, 0x85, 0x24, 0xee, 0x14, 0xc5,
+	0xfb, 0xeb, 0xce, 0xaf, 0x01, 0x00, 0x5f, 0x2a, 0x53, 0x78, 0x5f, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the vibc module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InFlightPackets queries the packets sent on behalf of the VM that await
+	// acknowledgement or timeout.
+	InFlightPackets(ctx context.Context, in *QueryInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsResponse, error)
+	// BoundPorts queries the ports dynamically bound by the VM.
+	BoundPorts(ctx context.Context, in *QueryBoundPortsRequest, opts ...grpc.CallOption) (*QueryBoundPortsResponse, error)
+	// OverdueAcks queries the packets received by the VM whose acknowledgement
+	// deadline has passed but that have yet to be acknowledged.
+	OverdueAcks(ctx context.Context, in *QueryOverdueAcksRequest, opts ...grpc.CallOption) (*QueryOverdueAcksResponse, error)
+	// ChannelDiagnostics queries the sequences of the channels of a VM-owned
+	// port against the packets that the VM has yet to settle.  The port is
+	// required, so as not to walk every channel of the chain; BoundPorts lists
+	// the candidates.
+	ChannelDiagnostics(ctx context.Context, in *QueryChannelDiagnosticsRequest, opts ...grpc.CallOption) (*QueryChannelDiagnosticsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vibc.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InFlightPackets(ctx context.Context, in *QueryInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsResponse, error) {
+	out := new(QueryInFlightPacketsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vibc.Query/InFlightPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BoundPorts(ctx context.Context, in *QueryBoundPortsRequest, opts ...grpc.CallOption) (*QueryBoundPortsResponse, error) {
+	out := new(QueryBoundPortsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vibc.Query/BoundPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OverdueAcks(ctx context.Context, in *QueryOverdueAcksRequest, opts ...grpc.CallOption) (*QueryOverdueAcksResponse, error) {
+	out := new(QueryOverdueAcksResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vibc.Query/OverdueAcks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelDiagnostics(ctx context.Context, in *QueryChannelDiagnosticsRequest, opts ...grpc.CallOption) (*QueryChannelDiagnosticsResponse, error) {
+	out := new(QueryChannelDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vibc.Query/ChannelDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the vibc module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InFlightPackets queries the packets sent on behalf of the VM that await
+	// acknowledgement or timeout.
+	InFlightPackets(context.Context, *QueryInFlightPacketsRequest) (*QueryInFlightPacketsResponse, error)
+	// BoundPorts queries the ports dynamically bound by the VM.
+	BoundPorts(context.Context, *QueryBoundPortsRequest) (*QueryBoundPortsResponse, error)
+	// OverdueAcks queries the packets received by the VM whose acknowledgement
+	// deadline has passed but that have yet to be acknowledged.
+	OverdueAcks(context.Context, *QueryOverdueAcksRequest) (*QueryOverdueAcksResponse, error)
+	// ChannelDiagnostics queries the sequences of the channels of a VM-owned
+	// port against the packets that the VM has yet to settle.  The port is
+	// required, so as not to walk every channel of the chain; BoundPorts lists
+	// the candidates.
+	ChannelDiagnostics(context.Context, *QueryChannelDiagnosticsRequest) (*QueryChannelDiagnosticsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) InFlightPackets(ctx context.Context, req *QueryInFlightPacketsRequest) (*QueryInFlightPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPackets not implemented")
+}
+func (*UnimplementedQueryServer) BoundPorts(ctx context.Context, req *QueryBoundPortsRequest) (*QueryBoundPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoundPorts not implemented")
+}
+func (*UnimplementedQueryServer) OverdueAcks(ctx context.Context, req *QueryOverdueAcksRequest) (*QueryOverdueAcksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverdueAcks not implemented")
+}
+func (*UnimplementedQueryServer) ChannelDiagnostics(ctx context.Context, req *QueryChannelDiagnosticsRequest) (*QueryChannelDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelDiagnostics not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vibc.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInFlightPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vibc.Query/InFlightPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPackets(ctx, req.(*QueryInFlightPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BoundPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBoundPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BoundPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vibc.Query/BoundPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BoundPorts(ctx, req.(*QueryBoundPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OverdueAcks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOverdueAcksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OverdueAcks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vibc.Query/OverdueAcks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OverdueAcks(ctx, req.(*QueryOverdueAcksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vibc.Query/ChannelDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelDiagnostics(ctx, req.(*QueryChannelDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.vibc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "InFlightPackets",
+			Handler:    _Query_InFlightPackets_Handler,
+		},
+		{
+			MethodName: "BoundPorts",
+			Handler:    _Query_BoundPorts_Handler,
+		},
+		{
+			MethodName: "OverdueAcks",
+			Handler:    _Query_OverdueAcks_Handler,
+		},
+		{
+			MethodName: "ChannelDiagnostics",
+			Handler:    _Query_ChannelDiagnostics_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/vibc/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBoundPortsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoundPortsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoundPortsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBoundPortsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoundPortsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoundPortsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ports) > 0 {
+		for iNdEx := len(m.Ports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOverdueAcksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOverdueAcksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOverdueAcksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOverdueAcksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOverdueAcksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOverdueAcksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Acks) > 0 {
+		for iNdEx := len(m.Acks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelDiagnosticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelDiagnosticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelDiagnosticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelDiagnosticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelDiagnosticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelDiagnosticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInFlightPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBoundPortsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBoundPortsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOverdueAcksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOverdueAcksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Acks) > 0 {
+		for _, e := range m.Acks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChannelDiagnosticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelDiagnosticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, InFlightPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBoundPortsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBoundPortsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBoundPortsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBoundPortsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBoundPortsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBoundPortsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, BoundPort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOverdueAcksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOverdueAcksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOverdueAcksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOverdueAcksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOverdueAcksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOverdueAcksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acks = append(m.Acks, PendingAck{})
+			if err := m.Acks[len(m.Acks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelDiagnosticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelDiagnosticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelDiagnosticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelDiagnosticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelDiagnosticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelDiagnosticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelDiagnostic{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
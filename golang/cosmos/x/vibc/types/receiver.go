@@ -2,7 +2,6 @@ package types
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/Agoric/agoric-sdk/golang/cosmos/types"
@@ -15,18 +14,24 @@ import (
 )
 
 var (
-	_ vm.PortHandler           = (*Receiver)(nil)
-	_ exported.Acknowledgement = (*RawAcknowledgement)(nil)
+	_ vm.PortHandler              = (*Receiver)(nil)
+	_ vm.MultiEncodingPortHandler = (*Receiver)(nil)
+	_ vm.PortDescriber            = (*Receiver)(nil)
+	_ exported.Acknowledgement    = (*RawAcknowledgement)(nil)
 )
 
 type ReceiverImpl interface {
-	ReceiveSendPacket(ctx sdk.Context, packet exported.PacketI) (uint64, error)
+	ReceiveSendPacket(ctx sdk.Context, target string, packet exported.PacketI) (uint64, error)
 	ReceiveWriteAcknowledgement(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement) error
 	ReceiveChanOpenInit(ctx sdk.Context, order channeltypes.Order, hops []string, sourcePort, destinationPort, version string) error
+	ReceiveWriteOpenInitChannel(ctx sdk.Context, packet exported.PacketI, order channeltypes.Order, connectionHops []string, version string) error
 	ReceiveWriteOpenTryChannel(ctx sdk.Context, packet exported.PacketI, order channeltypes.Order, connectionHops []string, version string) error
+	ReceiveOpenAckExecuted(ctx sdk.Context, portID, channelID, rejection string) error
 	ReceiveChanCloseInit(ctx sdk.Context, sourcePort, sourceChannel string) error
 	ReceiveBindPort(ctx sdk.Context, sourcePort string) error
+	ReceiveRevokePort(ctx sdk.Context, sourcePort string) error
 	ReceiveTimeoutExecuted(ctx sdk.Context, packet exported.PacketI) error
+	ReceiveResyncPackets(ctx sdk.Context, portID, channelID string) (uint64, error)
 }
 
 type Receiver struct {
@@ -39,7 +44,11 @@ func NewReceiver(impl ReceiverImpl) Receiver {
 	}
 }
 
-type portMessage struct { // comes from swingset's IBC handler
+// portMessage comes from swingset's IBC handler.  In JSON, relativeTimeoutNs
+// is a decimal string, since JSON numbers cannot carry every uint64.  CBOR
+// ignores the ",string" option, so there relativeTimeoutNs is an unsigned
+// integer (which CBOR carries exactly).
+type portMessage struct {
 	Type              string          `json:"type"` // IBC_METHOD
 	Method            string          `json:"method"`
 	Packet            types.IBCPacket `json:"packet"`
@@ -48,6 +57,15 @@ type portMessage struct { // comes from swingset's IBC handler
 	Hops              []string        `json:"hops"`
 	Version           string          `json:"version"`
 	Ack               []byte          `json:"ack"`
+	// Reason, if nonempty, rejects the counterparty version of an
+	// ackOpenExecuted channel.
+	Reason string `json:"reason,omitempty"`
+	// Target is the VM listener sending a packet, to be notified of its fate.
+	Target string `json:"target,omitempty"`
+}
+
+func init() {
+	vm.RegisterPortMessage("vibc", portMessage{})
 }
 
 func stringToOrder(order string) channeltypes.Order {
@@ -90,8 +108,65 @@ func (r RawAcknowledgement) Success() bool {
 	return true
 }
 
+// StoredAcknowledgement is an acknowledgement kept to be written later, which
+// remembers whether it was successful.
+type StoredAcknowledgement struct {
+	data    []byte
+	success bool
+}
+
+func NewStoredAcknowledgement(data []byte, success bool) StoredAcknowledgement {
+	return StoredAcknowledgement{
+		data:    data,
+		success: success,
+	}
+}
+
+func (r StoredAcknowledgement) Acknowledgement() []byte {
+	return r.data
+}
+
+func (r StoredAcknowledgement) Success() bool {
+	return r.success
+}
+
+// Encodings implements vm.MultiEncodingPortHandler.  CBOR carries packet data
+// and acknowledgements as byte strings instead of base64 text, and
+// relativeTimeoutNs as an integer instead of a decimal string.
+func (ir Receiver) Encodings() []vm.Encoding {
+	return []vm.Encoding{vm.EncodingCBOR}
+}
+
+// ibcMethods are the methods of "IBC_METHOD" messages accepted by Receive.
+var ibcMethods = []string{
+	"sendPacket",
+	"initOpenExecuted",
+	"tryOpenExecuted",
+	"ackOpenExecuted",
+	"receiveExecuted",
+	"startChannelOpenInit",
+	"startChannelCloseInit",
+	"bindPort",
+	"revokePort",
+	"timeoutExecuted",
+	"resyncPackets",
+}
+
+// DescribePort implements vm.PortDescriber.  The methods include those of the
+// wrapped ReceiverImpl if it is also a vm.PortDescriber.
+func (ir Receiver) DescribePort() vm.PortCapabilities {
+	caps := vm.PortCapabilities{
+		Version: "1",
+		Methods: append([]string{}, ibcMethods...),
+	}
+	if describer, ok := ir.impl.(vm.PortDescriber); ok {
+		caps.Methods = append(caps.Methods, describer.DescribePort().Methods...)
+	}
+	return caps
+}
+
 // Receive implements vm.PortHandler.  It unmarshals the string as JSON text
-// representing an IBC portMessage object.  If the resulting type is
+// (or CBOR, see Encodings) representing an IBC portMessage object.  If the resulting type is
 // "IBC_METHOD" it dispatches on method ("sendPacket"/"receiveExecuted"/etc.)
 // and calls the corresponding method of the wrapped ReceiverImpl.
 //
@@ -102,7 +177,7 @@ func (ir Receiver) Receive(cctx context.Context, jsonRequest string) (jsonReply
 	impl := ir.impl
 
 	msg := new(portMessage)
-	err = json.Unmarshal([]byte(jsonRequest), &msg)
+	enc, err := vm.UnmarshalData(jsonRequest, msg)
 	if err != nil {
 		return "", err
 	}
@@ -124,17 +199,17 @@ func (ir Receiver) Receive(cctx context.Context, jsonRequest string) (jsonReply
 
 		packet := types.CopyToIBCPacket(msg.Packet)
 		packet.TimeoutTimestamp = timeoutTimestamp
-		seq, err := impl.ReceiveSendPacket(ctx, packet)
+		seq, err := impl.ReceiveSendPacket(ctx, msg.Target, packet)
 		if err == nil {
 			packet.Sequence = seq
-			bytes, err := json.Marshal(packet)
-			if err == nil {
-				jsonReply = string(bytes)
-			}
+			jsonReply, err = vm.MarshalData(enc, packet)
 		}
 
 	case "initOpenExecuted":
-		err = fmt.Errorf("initOpenExecuted not yet implemented")
+		err = impl.ReceiveWriteOpenInitChannel(
+			ctx, msg.Packet,
+			stringToOrder(msg.Order), msg.Hops, msg.Version,
+		)
 
 	case "tryOpenExecuted":
 		err = impl.ReceiveWriteOpenTryChannel(
@@ -142,6 +217,9 @@ func (ir Receiver) Receive(cctx context.Context, jsonRequest string) (jsonReply
 			stringToOrder(msg.Order), msg.Hops, msg.Version,
 		)
 
+	case "ackOpenExecuted":
+		err = impl.ReceiveOpenAckExecuted(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.Reason)
+
 	case "receiveExecuted":
 		ack := RawAcknowledgement{
 			data: msg.Ack,
@@ -162,15 +240,25 @@ func (ir Receiver) Receive(cctx context.Context, jsonRequest string) (jsonReply
 	case "bindPort":
 		err = impl.ReceiveBindPort(ctx, msg.Packet.SourcePort)
 
+	case "revokePort":
+		err = impl.ReceiveRevokePort(ctx, msg.Packet.SourcePort)
+
 	case "timeoutExecuted":
 		err = impl.ReceiveTimeoutExecuted(ctx, msg.Packet)
 
+	case "resyncPackets":
+		var resynced uint64
+		resynced, err = impl.ReceiveResyncPackets(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
+		if err == nil {
+			jsonReply, err = vm.MarshalData(enc, resynced)
+		}
+
 	default:
 		err = fmt.Errorf("unrecognized method %s", msg.Method)
 	}
 
 	if jsonReply == "" && err == nil {
-		jsonReply = "true"
+		jsonReply, err = vm.MarshalData(enc, true)
 	}
 	return
 }
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	agtypes "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
+)
+
+func TestPortMessageEncodings(t *testing.T) {
+	msg := portMessage{
+		Type:              "IBC_METHOD",
+		Method:            "sendPacket",
+		Packet:            agtypes.MakeIBCPacket([]byte{0, 1, 2, 0xff}, 1, "port-1", "channel-0", "port-9", "channel-9", clienttypes.NewHeight(0, 100), 0),
+		RelativeTimeoutNs: 1<<64 - 1,
+		Hops:              []string{"connection-0"},
+		Ack:               []byte("ok"),
+		Target:            "agoric1target",
+	}
+
+	for _, enc := range []vm.Encoding{vm.EncodingJSON, vm.EncodingCBOR} {
+		t.Run(string(enc), func(t *testing.T) {
+			data, err := vm.MarshalData(enc, msg)
+			require.NoError(t, err)
+			var decoded portMessage
+			decodedEnc, err := vm.UnmarshalData(data, &decoded)
+			require.NoError(t, err)
+			require.Equal(t, enc, decodedEnc)
+			require.Equal(t, msg, decoded)
+		})
+	}
+
+	// JSON carries relativeTimeoutNs as a decimal string...
+	data, err := vm.MarshalData(vm.EncodingJSON, msg)
+	require.NoError(t, err)
+	var jsonFields map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(data), &jsonFields))
+	require.Equal(t, "18446744073709551615", jsonFields["relativeTimeoutNs"])
+
+	// ...but CBOR carries it as an unsigned integer.
+	data, err = vm.MarshalData(vm.EncodingCBOR, msg)
+	require.NoError(t, err)
+	var cborFields map[string]interface{}
+	bz, err := base64.StdEncoding.DecodeString(data)
+	require.NoError(t, err)
+	require.NoError(t, cbor.Unmarshal(bytes.TrimPrefix(bz, []byte(vm.CBORPrefix)), &cborFields))
+	require.Equal(t, uint64(1<<64-1), cborFields["relativeTimeoutNs"])
+
+	var decoded portMessage
+	_, err = vm.UnmarshalData(`{"type":"IBC_METHOD","relativeTimeoutNs":"600000000000"}`, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, uint64(600_000_000_000), decoded.RelativeTimeoutNs)
+}
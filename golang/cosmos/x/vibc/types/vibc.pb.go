@@ -0,0 +1,1779 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: agoric/vibc/vibc.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket is a packet sent on behalf of the VM that has been neither
+// acknowledged nor timed out.
+type InFlightPacket struct {
+	// packet is the packet as sent, including its sequence and timeout.
+	Packet Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// target is the VM listener to notify of the packet's fate, if the port's
+	// handler is not the listener.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// send_height is the block height at which the packet was sent.
+	SendHeight int64 `protobuf:"varint,3,opt,name=send_height,json=sendHeight,proto3" json:"send_height,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetPacket() Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return Packet{}
+}
+
+func (m *InFlightPacket) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetSendHeight() int64 {
+	if m != nil {
+		return m.SendHeight
+	}
+	return 0
+}
+
+// BoundPort is a port dynamically bound to the vibc module by the VM.
+type BoundPort struct {
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// bind_height is the block height at which the port was bound, or zero if
+	// it was bound before heights were recorded.
+	BindHeight int64 `protobuf:"varint,2,opt,name=bind_height,json=bindHeight,proto3" json:"bind_height,omitempty"`
+}
+
+func (m *BoundPort) Reset()         { *m = BoundPort{} }
+func (m *BoundPort) String() string { return proto.CompactTextString(m) }
+func (*BoundPort) ProtoMessage()    {}
+func (*BoundPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{1}
+}
+func (m *BoundPort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BoundPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BoundPort.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BoundPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundPort.Merge(m, src)
+}
+func (m *BoundPort) XXX_Size() int {
+	return m.Size()
+}
+func (m *BoundPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundPort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundPort proto.InternalMessageInfo
+
+func (m *BoundPort) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *BoundPort) GetBindHeight() int64 {
+	if m != nil {
+		return m.BindHeight
+	}
+	return 0
+}
+
+// Params are the vibc module parameters.
+type Params struct {
+	// ack_deadline_blocks is how many blocks the VM has to acknowledge a packet
+	// it received asynchronously, after which vibc writes an error
+	// acknowledgement on its behalf.  Zero disables the deadline.
+	AckDeadlineBlocks uint64 `protobuf:"varint,1,opt,name=ack_deadline_blocks,json=ackDeadlineBlocks,proto3" json:"ack_deadline_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAckDeadlineBlocks() uint64 {
+	if m != nil {
+		return m.AckDeadlineBlocks
+	}
+	return 0
+}
+
+// PendingAck is a packet received by the VM that has not yet been
+// acknowledged.
+type PendingAck struct {
+	Packet Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// target is the VM listener that received the packet, if the port's handler
+	// is not the listener.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// receive_height is the block height at which the packet was received.
+	ReceiveHeight int64 `protobuf:"varint,3,opt,name=receive_height,json=receiveHeight,proto3" json:"receive_height,omitempty"`
+	// deadline_height is the block height at which an acknowledgement is
+	// written if the VM has not acknowledged the packet, or zero for none.
+	DeadlineHeight int64 `protobuf:"varint,4,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	// ack is the acknowledgement of the module that received the packet before
+	// handing it to the VM, such as the ICS-20 result of a transfer whose tokens
+	// were credited.  It is written as is on expiry, since an error
+	// acknowledgement would refund the sender as well.  Only a packet received
+	// by a VM-owned port, with no target, expires with an error instead.
+	Ack []byte `protobuf:"bytes,5,opt,name=ack,proto3" json:"ack,omitempty"`
+	// ack_success is whether ack is a successful acknowledgement.
+	AckSuccess bool `protobuf:"varint,6,opt,name=ack_success,json=ackSuccess,proto3" json:"ack_success,omitempty"`
+}
+
+func (m *PendingAck) Reset()         { *m = PendingAck{} }
+func (m *PendingAck) String() string { return proto.CompactTextString(m) }
+func (*PendingAck) ProtoMessage()    {}
+func (*PendingAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{3}
+}
+func (m *PendingAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAck.Merge(m, src)
+}
+func (m *PendingAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAck proto.InternalMessageInfo
+
+func (m *PendingAck) GetPacket() Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return Packet{}
+}
+
+func (m *PendingAck) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *PendingAck) GetReceiveHeight() int64 {
+	if m != nil {
+		return m.ReceiveHeight
+	}
+	return 0
+}
+
+func (m *PendingAck) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *PendingAck) GetAck() []byte {
+	if m != nil {
+		return m.Ack
+	}
+	return nil
+}
+
+func (m *PendingAck) GetAckSuccess() bool {
+	if m != nil {
+		return m.AckSuccess
+	}
+	return false
+}
+
+// ChannelDiagnostic compares the sequences of a channel of a VM-owned port
+// with the packets that the VM has yet to settle, to find where an ordered
+// channel is jammed.
+type ChannelDiagnostic struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	State     string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Ordering  string `protobuf:"bytes,4,opt,name=ordering,proto3" json:"ordering,omitempty"`
+	// The channel's next sequences, as kept by IBC.
+	NextSequenceSend uint64 `protobuf:"varint,5,opt,name=next_sequence_send,json=nextSequenceSend,proto3" json:"next_sequence_send,omitempty"`
+	NextSequenceRecv uint64 `protobuf:"varint,6,opt,name=next_sequence_recv,json=nextSequenceRecv,proto3" json:"next_sequence_recv,omitempty"`
+	NextSequenceAck  uint64 `protobuf:"varint,7,opt,name=next_sequence_ack,json=nextSequenceAck,proto3" json:"next_sequence_ack,omitempty"`
+	// in_flight_packets counts the packets sent by the VM that await
+	// acknowledgement or timeout, the oldest having oldest_in_flight_sequence.
+	InFlightPackets        uint64 `protobuf:"varint,8,opt,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets,omitempty"`
+	OldestInFlightSequence uint64 `protobuf:"varint,9,opt,name=oldest_in_flight_sequence,json=oldestInFlightSequence,proto3" json:"oldest_in_flight_sequence,omitempty"`
+	// pending_acks counts the packets received that the VM has yet to
+	// acknowledge, the oldest having oldest_pending_ack_sequence.
+	PendingAcks              uint64 `protobuf:"varint,10,opt,name=pending_acks,json=pendingAcks,proto3" json:"pending_acks,omitempty"`
+	OldestPendingAckSequence uint64 `protobuf:"varint,11,opt,name=oldest_pending_ack_sequence,json=oldestPendingAckSequence,proto3" json:"oldest_pending_ack_sequence,omitempty"`
+}
+
+func (m *ChannelDiagnostic) Reset()         { *m = ChannelDiagnostic{} }
+func (m *ChannelDiagnostic) String() string { return proto.CompactTextString(m) }
+func (*ChannelDiagnostic) ProtoMessage()    {}
+func (*ChannelDiagnostic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108461a452569267, []int{4}
+}
+func (m *ChannelDiagnostic) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelDiagnostic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelDiagnostic.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelDiagnostic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDiagnostic.Merge(m, src)
+}
+func (m *ChannelDiagnostic) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelDiagnostic) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDiagnostic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDiagnostic proto.InternalMessageInfo
+
+func (m *ChannelDiagnostic) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelDiagnostic) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelDiagnostic) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ChannelDiagnostic) GetOrdering() string {
+	if m != nil {
+		return m.Ordering
+	}
+	return ""
+}
+
+func (m *ChannelDiagnostic) GetNextSequenceSend() uint64 {
+	if m != nil {
+		return m.NextSequenceSend
+	}
+	return 0
+}
+
+func (m *ChannelDiagnostic) GetNextSequenceRecv() uint64 {
+	if m != nil {
+		return m.NextSequenceRecv
+	}
+	return 0
+}
+
+func (m *ChannelDiagnostic) GetNextSequenceAck() uint64 {
+	if m != nil {
+		return m.NextSequenceAck
+	}
+	return 0
+}
+
+func (m *ChannelDiagnostic) GetInFlightPackets() uint64 {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return 0
+}
+
+func (m *ChannelDiagnostic) GetOldestInFlightSequence() uint64 {
+	if m != nil {
+		return m.OldestInFlightSequence
+	}
+	return 0
+}
+
+func (m *ChannelDiagnostic) GetPendingAcks() uint64 {
+	if m != nil {
+		return m.PendingAcks
+	}
+	return 0
+}
+
+func (m *ChannelDiagnostic) GetOldestPendingAckSequence() uint64 {
+	if m != nil {
+		return m.OldestPendingAckSequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "agoric.vibc.InFlightPacket")
+	proto.RegisterType((*BoundPort)(nil), "agoric.vibc.BoundPort")
+	proto.RegisterType((*Params)(nil), "agoric.vibc.Params")
+	proto.RegisterType((*PendingAck)(nil), "agoric.vibc.PendingAck")
+	proto.RegisterType((*ChannelDiagnostic)(nil), "agoric.vibc.ChannelDiagnostic")
+}
+
+func init() { proto.RegisterFile("agoric/vibc/vibc.proto", fileDescriptor_108461a452569267) }
+
+var fileDescriptor_108461a452569267 = []byte{
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xb3, 0x4d, 0xba, 0xed, 0x4e, 0x4a, 0xdb, 0xb8, 0x55, 0x59, 0x8a, 0x48, 0x43, 0x24,
+	0x44, 0x54, 0x41, 0x22, 0xe0, 0x00, 0x1c, 0x38, 0x34, 0x14, 0x44, 0x6f, 0x61, 0x7b, 0xe3, 0xb2,
+	0x72, 0x6c, 0xe3, 0x58, 0x9b, 0xd8, 0x61, 0xed, 0x44, 0x45, 0xe2, 0x21, 0x78, 0xac, 0x9e, 0x50,
+	0x8f, 0x88, 0x03, 0x42, 0xed, 0x8b, 0x20, 0xdb, 0x9b, 0x7f, 0xaa, 0xb8, 0x71, 0x59, 0xd9, 0xdf,
+	0xfc, 0xfc, 0x79, 0xc6, 0x9e, 0x35, 0x1c, 0x60, 0xae, 0x72, 0x41, 0x3a, 0x53, 0xd1, 0xf7, 0x9f,
+	0xf6, 0x38, 0x57, 0x46, 0xa1, 0xaa, 0xd7, 0xdb, 0x56, 0x3a, 0xdc, 0xe7, 0x8a, 0x2b, 0xa7, 0x77,
+	0xec, 0xc8, 0x23, 0x87, 0x2b, 0x4b, 0x47, 0x9a, 0x6b, 0xaf, 0x37, 0xbf, 0xc1, 0xf6, 0x99, 0x7c,
+	0x3f, 0x14, 0x7c, 0x60, 0x7a, 0x98, 0x64, 0xcc, 0xa0, 0x67, 0x10, 0x8e, 0xdd, 0x28, 0x0e, 0x1a,
+	0x41, 0xab, 0xfa, 0x7c, 0xaf, 0xbd, 0xe4, 0xde, 0xf6, 0x50, 0xb7, 0x72, 0xf9, 0xfb, 0xa8, 0x94,
+	0x14, 0x20, 0x3a, 0x80, 0xd0, 0xe0, 0x9c, 0x33, 0x13, 0xaf, 0x35, 0x82, 0x56, 0x94, 0x14, 0x33,
+	0x74, 0x04, 0x55, 0xcd, 0x24, 0x4d, 0x07, 0xcc, 0xfa, 0xc7, 0xe5, 0x46, 0xd0, 0x2a, 0x27, 0x60,
+	0xa5, 0x0f, 0x4e, 0x69, 0xbe, 0x83, 0xa8, 0xab, 0x26, 0x92, 0xf6, 0x54, 0x6e, 0xd0, 0x5d, 0xd8,
+	0x18, 0xab, 0xdc, 0xa4, 0x82, 0xba, 0x9d, 0xa3, 0x24, 0xb4, 0xd3, 0x33, 0x6a, 0x6d, 0xfa, 0x62,
+	0x61, 0xb3, 0xe6, 0x6d, 0xfa, 0x62, 0x6e, 0xf3, 0x0a, 0xc2, 0x1e, 0xce, 0xf1, 0x48, 0xa3, 0x36,
+	0xec, 0x61, 0x92, 0xa5, 0x94, 0x61, 0x3a, 0x14, 0x92, 0xa5, 0xfd, 0xa1, 0x22, 0x99, 0x76, 0x7e,
+	0x95, 0xa4, 0x86, 0x49, 0x76, 0x5a, 0x44, 0xba, 0x2e, 0xd0, 0xfc, 0x15, 0x00, 0xf4, 0x98, 0xa4,
+	0x42, 0xf2, 0x13, 0x92, 0xfd, 0xcf, 0xda, 0x1f, 0xc1, 0x76, 0xce, 0x08, 0x13, 0x53, 0xb6, 0x5a,
+	0xfe, 0x9d, 0x42, 0xf5, 0xa9, 0xa3, 0xc7, 0xb0, 0x33, 0x4f, 0xb6, 0xe0, 0x2a, 0x8e, 0xdb, 0x9e,
+	0xc9, 0x05, 0xb8, 0x0b, 0x65, 0x4c, 0xb2, 0x78, 0xbd, 0x11, 0xb4, 0xb6, 0x12, 0x3b, 0xb4, 0xc7,
+	0x62, 0x6b, 0xd5, 0x13, 0x42, 0x98, 0xd6, 0x71, 0xd8, 0x08, 0x5a, 0x9b, 0x09, 0x60, 0x92, 0x9d,
+	0x7b, 0xa5, 0xf9, 0xa3, 0x0c, 0xb5, 0xb7, 0x03, 0x2c, 0x25, 0x1b, 0x9e, 0x0a, 0xcc, 0xa5, 0xd2,
+	0x46, 0x90, 0x7f, 0x1f, 0xf3, 0x03, 0x00, 0xe2, 0x69, 0x1b, 0xf3, 0xd5, 0x44, 0x85, 0x72, 0x46,
+	0xd1, 0x3e, 0xac, 0x6b, 0x83, 0x0d, 0x73, 0x75, 0x44, 0x89, 0x9f, 0xa0, 0x43, 0xd8, 0x54, 0x39,
+	0x65, 0xb9, 0x90, 0xdc, 0x25, 0x1e, 0x25, 0xf3, 0x39, 0x7a, 0x02, 0x48, 0xb2, 0x0b, 0x93, 0x6a,
+	0xf6, 0x65, 0xc2, 0x24, 0x61, 0xa9, 0xbd, 0x79, 0x57, 0x41, 0x25, 0xd9, 0xb5, 0x91, 0xf3, 0x22,
+	0x70, 0xce, 0x24, 0xbd, 0x4d, 0xe7, 0x8c, 0x4c, 0xe3, 0xf0, 0x36, 0x9d, 0x30, 0x32, 0x45, 0xc7,
+	0x50, 0x5b, 0xa5, 0xed, 0xe1, 0x6c, 0x38, 0x78, 0x67, 0x19, 0xb6, 0xb7, 0x7a, 0x0c, 0x35, 0x21,
+	0xd3, 0xcf, 0xae, 0xc9, 0x53, 0x7f, 0x6d, 0x3a, 0xde, 0xf4, 0xac, 0x58, 0x69, 0x7e, 0x8d, 0x5e,
+	0xc3, 0x3d, 0x35, 0xa4, 0x4c, 0x9b, 0x74, 0xb1, 0x64, 0xb6, 0x47, 0x1c, 0xb9, 0x35, 0x07, 0x1e,
+	0x98, 0xfd, 0x36, 0xb3, 0x9d, 0xd0, 0x43, 0xd8, 0x1a, 0xfb, 0x56, 0xb2, 0xc9, 0xe8, 0x18, 0x1c,
+	0x5d, 0x1d, 0xcf, 0xdb, 0x4b, 0xa3, 0x37, 0x70, 0xbf, 0x70, 0x5f, 0x22, 0x17, 0xfe, 0x55, 0xb7,
+	0x22, 0xf6, 0xc8, 0xa2, 0x2d, 0x67, 0x3b, 0x74, 0x3f, 0x5e, 0x5e, 0xd7, 0x83, 0xab, 0xeb, 0x7a,
+	0xf0, 0xe7, 0xba, 0x1e, 0x7c, 0xbf, 0xa9, 0x97, 0xae, 0x6e, 0xea, 0xa5, 0x9f, 0x37, 0xf5, 0xd2,
+	0xa7, 0x97, 0x5c, 0x98, 0xc1, 0xa4, 0xdf, 0x26, 0x6a, 0xd4, 0x39, 0xf1, 0x7f, 0xba, 0xef, 0xdc,
+	0xa7, 0x9a, 0x66, 0x1d, 0xae, 0x86, 0x58, 0xf2, 0x0e, 0x51, 0x7a, 0xa4, 0x74, 0xe7, 0xc2, 0x3f,
+	0x02, 0xe6, 0xeb, 0x98, 0xe9, 0x7e, 0xe8, 0x9e, 0x81, 0x17, 0x7f, 0x07, 0x00, 0x5a, 0x08, 0x28,
+	0xc7, 0x5b, 0x04, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SendHeight != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.SendHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BoundPort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoundPort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoundPort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BindHeight != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.BindHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AckDeadlineBlocks != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.AckDeadlineBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AckSuccess {
+		i--
+		if m.AckSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Ack) > 0 {
+		i -= len(m.Ack)
+		copy(dAtA[i:], m.Ack)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.Ack)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReceiveHeight != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.ReceiveHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelDiagnostic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelDiagnostic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelDiagnostic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OldestPendingAckSequence != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.OldestPendingAckSequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PendingAcks != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.PendingAcks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.OldestInFlightSequence != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.OldestInFlightSequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InFlightPackets != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.InFlightPackets))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextSequenceAck != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.NextSequenceAck))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextSequenceRecv != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.NextSequenceRecv))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextSequenceSend != 0 {
+		i = encodeVarintVibc(dAtA, i, uint64(m.NextSequenceSend))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Ordering) > 0 {
+		i -= len(m.Ordering)
+		copy(dAtA[i:], m.Ordering)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.Ordering)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintVibc(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVibc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVibc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovVibc(uint64(l))
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	if m.SendHeight != 0 {
+		n += 1 + sovVibc(uint64(m.SendHeight))
+	}
+	return n
+}
+
+func (m *BoundPort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	if m.BindHeight != 0 {
+		n += 1 + sovVibc(uint64(m.BindHeight))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AckDeadlineBlocks != 0 {
+		n += 1 + sovVibc(uint64(m.AckDeadlineBlocks))
+	}
+	return n
+}
+
+func (m *PendingAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovVibc(uint64(l))
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	if m.ReceiveHeight != 0 {
+		n += 1 + sovVibc(uint64(m.ReceiveHeight))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovVibc(uint64(m.DeadlineHeight))
+	}
+	l = len(m.Ack)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	if m.AckSuccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChannelDiagnostic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	l = len(m.Ordering)
+	if l > 0 {
+		n += 1 + l + sovVibc(uint64(l))
+	}
+	if m.NextSequenceSend != 0 {
+		n += 1 + sovVibc(uint64(m.NextSequenceSend))
+	}
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovVibc(uint64(m.NextSequenceRecv))
+	}
+	if m.NextSequenceAck != 0 {
+		n += 1 + sovVibc(uint64(m.NextSequenceAck))
+	}
+	if m.InFlightPackets != 0 {
+		n += 1 + sovVibc(uint64(m.InFlightPackets))
+	}
+	if m.OldestInFlightSequence != 0 {
+		n += 1 + sovVibc(uint64(m.OldestInFlightSequence))
+	}
+	if m.PendingAcks != 0 {
+		n += 1 + sovVibc(uint64(m.PendingAcks))
+	}
+	if m.OldestPendingAckSequence != 0 {
+		n += 1 + sovVibc(uint64(m.OldestPendingAckSequence))
+	}
+	return n
+}
+
+func sovVibc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVibc(x uint64) (n int) {
+	return sovVibc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendHeight", wireType)
+			}
+			m.SendHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoundPort) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoundPort: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoundPort: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindHeight", wireType)
+			}
+			m.BindHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BindHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckDeadlineBlocks", wireType)
+			}
+			m.AckDeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckDeadlineBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveHeight", wireType)
+			}
+			m.ReceiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ack = append(m.Ack[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ack == nil {
+				m.Ack = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AckSuccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelDiagnostic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelDiagnostic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelDiagnostic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ordering = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
+			}
+			m.NextSequenceSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceSend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceAck", wireType)
+			}
+			m.NextSequenceAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			m.InFlightPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InFlightPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestInFlightSequence", wireType)
+			}
+			m.OldestInFlightSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestInFlightSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAcks", wireType)
+			}
+			m.PendingAcks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingAcks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingAckSequence", wireType)
+			}
+			m.OldestPendingAckSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestPendingAckSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVibc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVibc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVibc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVibc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVibc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVibc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVibc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVibc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVibc = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,7 +12,7 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vlocalchain/types"
 )
 
-var _ vm.PortHandler = (*portHandler)(nil)
+var _ vm.PortDescriber = (*portHandler)(nil)
 
 type portHandler struct {
 	keeper keeper.Keeper
@@ -24,10 +24,26 @@ type portMessage struct {
 	Messages json.RawMessage `json:"messages,omitempty"`
 }
 
+func init() {
+	vm.RegisterPortMessage("vlocalchain", portMessage{})
+}
+
 func NewReceiver(keeper keeper.Keeper) portHandler {
 	return portHandler{keeper: keeper}
 }
 
+// DescribePort implements vm.PortDescriber.
+func (h portHandler) DescribePort() vm.PortCapabilities {
+	return vm.PortCapabilities{
+		Version: "1",
+		Methods: []string{
+			"VLOCALCHAIN_ALLOCATE_ADDRESS",
+			"VLOCALCHAIN_QUERY_MANY",
+			"VLOCALCHAIN_EXECUTE_TX",
+		},
+	}
+}
+
 func (h portHandler) Receive(cctx context.Context, str string) (ret string, err error) {
 	var msg portMessage
 	err = json.Unmarshal([]byte(str), &msg)
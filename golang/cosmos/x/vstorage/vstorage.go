@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	agoric "github.com/Agoric/agoric-sdk/golang/cosmos/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/vm"
 )
 
 type vstorageHandler struct {
@@ -21,6 +22,10 @@ type vstorageMessage struct {
 	Args   []json.RawMessage `json:"args"`
 }
 
+func init() {
+	vm.RegisterPortMessage("vstorage", vstorageMessage{})
+}
+
 type vstorageStoreKey struct {
 	StoreName       string `json:"storeName"`
 	StoreSubkey     string `json:"storeSubkey"`
@@ -32,6 +37,18 @@ func NewStorageHandler(keeper Keeper) vstorageHandler {
 	return vstorageHandler{keeper: keeper}
 }
 
+// DescribePort implements vm.PortDescriber.
+func (sh vstorageHandler) DescribePort() vm.PortCapabilities {
+	return vm.PortCapabilities{
+		Version: "1",
+		Methods: []string{
+			"set", "legacySet", "setWithoutNotify", "append",
+			"get", "getStoreKey", "has", "children", "keys",
+			"entries", "values", "size",
+		},
+	}
+}
+
 func unmarshalSinglePathFromArgs(args []json.RawMessage, path *string) error {
 	if len(args) == 0 {
 		return fmt.Errorf("missing 'path' argument")
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	vtransferQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vtransfer module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vtransferQueryCmd.AddCommand(
+		GetCmdQueryWatchedTargets(),
+	)
+
+	return vtransferQueryCmd
+}
+
+// GetCmdQueryWatchedTargets implements the query watched-targets command.
+func GetCmdQueryWatchedTargets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watched-targets",
+		Args:  cobra.NoArgs,
+		Short: "Query the addresses registered by the VM, with counts of the packets intercepted for each",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.WatchedTargets(cmd.Context(), &types.QueryWatchedTargetsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "watched-targets")
+	return cmd
+}
@@ -15,6 +15,26 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if data == nil {
 		return fmt.Errorf("vtransfer genesis data cannot be nil")
 	}
+	seenAddresses := make(map[string]bool, len(data.WatchedAddresses))
+	for _, address := range data.WatchedAddresses {
+		if err := sdk.VerifyAddressFormat(address); err != nil {
+			return fmt.Errorf("invalid vtransfer watched address %s: %s", address, err)
+		}
+		if seenAddresses[address.String()] {
+			return fmt.Errorf("duplicate vtransfer watched address %s", address)
+		}
+		seenAddresses[address.String()] = true
+	}
+	seenTargets := make(map[string]bool, len(data.WatchedTargets))
+	for _, target := range data.WatchedTargets {
+		if err := target.ValidateBasic(); err != nil {
+			return err
+		}
+		if seenTargets[target.Address] {
+			return fmt.Errorf("duplicate vtransfer watched target %s", target.Address)
+		}
+		seenTargets[target.Address] = true
+	}
 	return nil
 }
 
@@ -23,7 +43,14 @@ func DefaultGenesisState() *types.GenesisState {
 }
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data *types.GenesisState) {
-	keeper.SetWatchedAddresses(ctx, data.GetWatchedAddresses())
+	if err := keeper.SetWatchedAddresses(ctx, data.GetWatchedAddresses()); err != nil {
+		panic(err)
+	}
+	for _, target := range data.GetWatchedTargets() {
+		if err := keeper.SetWatchedTarget(ctx, target); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
@@ -33,5 +60,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 		panic(err)
 	}
 	gs.WatchedAddresses = addresses
+	gs.WatchedTargets, err = k.GetWatchedTargets(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &gs
 }
@@ -2,6 +2,19 @@ package vtransfer
 
 import (
 	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	vibckeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/keeper"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
 )
 
 func TestDefaultGenesis(t *testing.T) {
@@ -10,3 +23,68 @@ func TestDefaultGenesis(t *testing.T) {
 		t.Errorf("DefaultGenesisState did not validate %v: %e", defaultGenesisState, err)
 	}
 }
+
+func TestWatchedTargetsGenesis(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	makeKeeper := func() (sdk.Context, Keeper, *storetypes.KVStoreKey) {
+		key := storetypes.NewKVStoreKey(StoreKey)
+		ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+		k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), vibckeeper.Keeper{}, nil)
+		return ctx, k, key
+	}
+	ctx, k, key := makeKeeper()
+
+	registered := sdk.AccAddress([]byte("registered__________")).String()
+	legacy := sdk.AccAddress([]byte("legacy______________")).String()
+
+	// A target registered before records were kept has only a sentinel.
+	ctx.KVStore(key).Set([]byte("watchedAddress/"+legacy), []byte("y"))
+	_, err := k.Receive(ctx.WithBlockHeight(7), `{"type":"BRIDGE_TARGET_REGISTER","target":"`+registered+`"}`)
+	require.NoError(t, err)
+	// Registering again keeps the original record.
+	_, err = k.Receive(ctx.WithBlockHeight(9), `{"type":"BRIDGE_TARGET_REGISTER","target":"`+registered+`"}`)
+	require.NoError(t, err)
+
+	page, err := k.WatchedTargets(ctx, &types.QueryWatchedTargetsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, page.Targets, 1)
+	require.NotEmpty(t, page.Pagination.NextKey)
+
+	all, err := k.WatchedTargets(ctx, &types.QueryWatchedTargetsRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.WatchedTarget{
+		{Address: legacy},
+		{Address: registered, RegisterHeight: 7},
+	}, all.Targets)
+
+	require.NoError(t, k.SetWatchedTarget(ctx, types.WatchedTarget{Address: registered, RegisterHeight: 7, RecvCount: 2, AckCount: 1}))
+	exported := ExportGenesis(ctx, k)
+	require.Len(t, exported.WatchedAddresses, 2)
+	require.NoError(t, ValidateGenesis(exported))
+
+	newCtx, newK, _ := makeKeeper()
+	InitGenesis(newCtx.WithBlockHeight(1), newK, exported)
+	require.Equal(t, exported, ExportGenesis(newCtx, newK))
+
+	// Addresses without records are watched as of genesis.
+	newCtx, newK, _ = makeKeeper()
+	InitGenesis(newCtx.WithBlockHeight(1), newK, &types.GenesisState{WatchedAddresses: exported.WatchedAddresses})
+	targets, err := newK.GetWatchedTargets(newCtx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.WatchedTarget{
+		{Address: legacy, RegisterHeight: 1},
+		{Address: registered, RegisterHeight: 1},
+	}, targets)
+
+	_, err = k.Receive(ctx, `{"type":"BRIDGE_TARGET_UNREGISTER","target":"`+registered+`"}`)
+	require.NoError(t, err)
+	all, err = k.WatchedTargets(ctx, &types.QueryWatchedTargetsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.WatchedTarget{{Address: legacy}}, all.Targets)
+
+	require.Error(t, ValidateGenesis(&types.GenesisState{WatchedTargets: []types.WatchedTarget{{}}}))
+	require.ErrorContains(t, ValidateGenesis(&types.GenesisState{WatchedTargets: []types.WatchedTarget{{Address: "cosmos1bad"}}}), "cannot convert")
+	require.ErrorContains(t, ValidateGenesis(&types.GenesisState{WatchedTargets: []types.WatchedTarget{{Address: legacy}, {Address: legacy}}}), "duplicate")
+	require.ErrorContains(t, ValidateGenesis(&types.GenesisState{WatchedAddresses: []sdk.AccAddress{exported.WatchedAddresses[0], exported.WatchedAddresses[0]}}), "duplicate")
+	require.ErrorContains(t, ValidateGenesis(&types.GenesisState{WatchedAddresses: []sdk.AccAddress{{}}}), "invalid")
+}
@@ -25,6 +25,7 @@ import (
 	swingsettesting "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/testing"
 	swingsettypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/swingset/types"
 	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	vtransfertypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
 
 	sdkmath "cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -235,6 +236,20 @@ func (s *IntegrationTestSuite) GetChainByIndex(index int) *ibctesting.TestChain
 	return s.coordinator.GetChain(ibctesting.GetChainID(index))
 }
 
+func (s *IntegrationTestSuite) assertWatchedTarget(chain *ibctesting.TestChain, target string, recvCount, ackCount uint64) {
+	res, err := s.GetApp(chain).VtransferKeeper.WatchedTargets(chain.GetContext(), &vtransfertypes.QueryWatchedTargetsRequest{})
+	s.Require().NoError(err)
+	for _, watched := range res.Targets {
+		if watched.Address == target {
+			s.Require().Equal(recvCount, watched.RecvCount)
+			s.Require().Equal(ackCount, watched.AckCount)
+			s.Require().Zero(watched.TimeoutCount)
+			return
+		}
+	}
+	s.Failf("target not watched", "%s", target)
+}
+
 func (s *IntegrationTestSuite) GetApp(chain *ibctesting.TestChain) *app.GaiaApp {
 	app, ok := chain.App.(*app.GaiaApp)
 	if !ok {
@@ -462,10 +477,14 @@ func (s *IntegrationTestSuite) TestHops() {
 				baseReceiver := baseReceiverAddr.String()
 
 				var receiver, sender string
+				var senderHook, receiverHook *types.AddressHookPayload
 				var err error
 				if tc.senderHookData != nil {
 					sender, err = types.JoinHookedAddress(baseSender, tc.senderHookData)
 					s.Require().NoError(err)
+					_, senderPayload, err := types.DecodeAddressHookPayload(sender)
+					s.Require().NoError(err)
+					senderHook = &senderPayload
 				} else {
 					sender = baseSender
 				}
@@ -473,6 +492,9 @@ func (s *IntegrationTestSuite) TestHops() {
 				if tc.receiverHookData != nil {
 					receiver, err = types.JoinHookedAddress(baseReceiver, tc.receiverHookData)
 					s.Require().NoError(err)
+					_, receiverPayload, err := types.DecodeAddressHookPayload(receiver)
+					s.Require().NoError(err)
+					receiverHook = &receiverPayload
 				} else {
 					receiver = baseReceiver
 				}
@@ -650,6 +672,7 @@ func (s *IntegrationTestSuite) TestHops() {
 								},
 								Event:           "writeAcknowledgement",
 								Target:          baseReceiver,
+								Hook:            receiverHook,
 								Packet:          types.CopyToIBCPacket(sendPacket),
 								Acknowledgement: expectedAck.Acknowledgement(),
 							},
@@ -762,6 +785,7 @@ func (s *IntegrationTestSuite) TestHops() {
 								Event:           "acknowledgementPacket",
 								ChannelVersion:	 paths[0].EndpointA.ChannelConfig.Version,
 								Target:          baseSender,
+								Hook:            senderHook,
 								Packet:          types.CopyToIBCPacket(expectedPacket),
 								Acknowledgement: ack.Acknowledgement(),
 								Relayer:         s.chainA.SenderAccount.GetAddress(),
@@ -777,6 +801,14 @@ func (s *IntegrationTestSuite) TestHops() {
 					s.assertActionQueue(s.chainA, expectedRecords)
 				}
 
+				// Verify the packets counted for the watched targets.
+				if tc.senderIsTarget {
+					s.assertWatchedTarget(s.chainA, baseSender, 0, 1)
+				}
+				if tc.receiverIsTarget {
+					s.assertWatchedTarget(s.chainB, baseReceiver, 1, 0)
+				}
+
 				// Verify the resulting received coin balance.
 				req := &banktypes.QueryAllBalancesRequest{
 					Address: baseReceiver,
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// WatchedTargets queries the addresses registered by the VM, with counts of
+// the packets intercepted for each
+func (k Keeper) WatchedTargets(c context.Context, req *types.QueryWatchedTargetsRequest) (*types.QueryWatchedTargetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	targets := []types.WatchedTarget{}
+	pageRes, err := query.Paginate(k.watchedTargetStore(ctx), req.Pagination, func(key, value []byte) error {
+		target, err := k.unmarshalWatchedTarget(string(key), value)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, target)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWatchedTargetsResponse{Targets: targets, Pagination: pageRes}, nil
+}
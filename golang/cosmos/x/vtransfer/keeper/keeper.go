@@ -3,7 +3,6 @@ package keeper
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 
 	corestore "cosmossdk.io/core/store"
@@ -20,6 +19,7 @@ import (
 	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc"
 	vibckeeper "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/keeper"
 	vibctypes "github.com/Agoric/agoric-sdk/golang/cosmos/x/vibc/types"
+	"github.com/Agoric/agoric-sdk/golang/cosmos/x/vtransfer/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -31,12 +31,13 @@ import (
 var _ porttypes.ICS4Wrapper = (*Keeper)(nil)
 var _ porttypes.ICS4Wrapper = (*ics4Wrapper)(nil)
 var _ vibctypes.ReceiverImpl = (*Keeper)(nil)
-var _ vm.PortHandler = (*Keeper)(nil)
+var _ vm.PortDescriber = (*Keeper)(nil)
 
 // "watched addresses" is logically a set and physically a collection of
 // KVStore entries in which each key is a concatenation of a fixed prefix and
-// the address, and its corresponding value is a non-empty but otherwise irrelevant
-// sentinel.
+// the address, and its corresponding value is the address's WatchedTarget
+// record (or, if it was registered before records were kept, a non-empty but
+// otherwise irrelevant sentinel).
 const (
 	packetDataStoreKeyPrefix     = "originalData/"
 	watchedAddressStoreKeyPrefix = "watchedAddress/"
@@ -180,6 +181,10 @@ func wrapActionPusher(pusher vm.ActionPusher) vm.ActionPusher {
 	}
 }
 
+func init() {
+	vm.RegisterActionTypeAlias("VTRANSFER_IBC_EVENT", "IBC_EVENT")
+}
+
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k
 }
@@ -286,8 +291,11 @@ func (k Keeper) InterceptOnAcknowledgementPacket(
 		return modErr
 	}
 
+	k.countIntercepted(ctx, baseSender, interceptedAck)
+
 	// Trigger VM with the original packet, regardless of errors in the ibcModule.
-	vmErr := k.vibcKeeper.TriggerOnAcknowledgementPacket(ctx, baseSender, channelVersion, origPacket, acknowledgement, relayer)
+	hook := k.addressHook(origPacket, agtypes.RoleSender)
+	vmErr := k.vibcKeeper.TriggerOnAcknowledgementPacket(ctx, baseSender, hook, channelVersion, origPacket, acknowledgement, relayer)
 
 	// Any error from the VM is trumped by one from the wrapped IBC module.
 	if modErr != nil {
@@ -325,8 +333,11 @@ func (k Keeper) InterceptOnTimeoutPacket(
 		return modErr
 	}
 
+	k.countIntercepted(ctx, baseSender, interceptedTimeout)
+
 	// Trigger VM with the original packet, regardless of errors in the app.
-	vmErr := k.vibcKeeper.TriggerOnTimeoutPacket(ctx, baseSender, channelVersion, origPacket, relayer)
+	hook := k.addressHook(origPacket, agtypes.RoleSender)
+	vmErr := k.vibcKeeper.TriggerOnTimeoutPacket(ctx, baseSender, hook, channelVersion, origPacket, relayer)
 
 	// Any error from the VM is trumped by one from the wrapped IBC module.
 	if modErr != nil {
@@ -353,32 +364,151 @@ func (k Keeper) InterceptWriteAcknowledgement(ctx sdk.Context, packet ibcexporte
 		return ack, origPacket
 	}
 
+	k.countIntercepted(ctx, baseReceiver, interceptedRecv)
+
 	// Trigger VM with the original packet.
-	if err = k.vibcKeeper.TriggerWriteAcknowledgement(ctx, baseReceiver, origPacket, ack); err != nil {
+	hook := k.addressHook(origPacket, agtypes.RoleReceiver)
+	if err = k.vibcKeeper.TriggerWriteAcknowledgement(ctx, baseReceiver, hook, origPacket, ack); err != nil {
 		errAck := channeltypes.NewErrorAcknowledgement(err)
 		return errAck, origPacket
 	}
 
-	// The VM has taken over the ack, so we return nil to indicate that the ack is async.
+	// The VM has taken over the ack, so we return nil to indicate that the ack
+	// is async, and hold the VM to the deadline.  The transfer has already
+	// credited the receiver, so the deadline writes its own ack rather than an
+	// error that would refund the sender too.
+	if err = k.vibcKeeper.TrackPendingAck(ctx, baseReceiver, origPacket, ack); err != nil {
+		errAck := channeltypes.NewErrorAcknowledgement(err)
+		return errAck, origPacket
+	}
 	return nil, origPacket
 }
 
+// addressHook returns the decoded hook payload of the packet's sender or
+// receiver, or nil if there is none.  Hook data that is not a valid payload is
+// still available to the VM in the original packet.
+func (k Keeper) addressHook(packet ibcexported.PacketI, role agtypes.AddressRole) *agtypes.AddressHookPayload {
+	_, query, err := agtypes.ExtractAddressHookFromData(k.cdc, packet.GetData(), role)
+	if err != nil || len(query) == 0 {
+		return nil
+	}
+	payload, err := agtypes.ParseAddressHookPayload(query)
+	if err != nil {
+		return nil
+	}
+	return &payload
+}
+
 // targetIsWatched checks if a target address has been watched by the VM.
 func (k Keeper) targetIsWatched(ctx sdk.Context, target string) bool {
+	return k.watchedTargetStore(ctx).Has([]byte(target))
+}
+
+func (k Keeper) watchedTargetStore(ctx sdk.Context) prefix.Store {
 	kvstore := k.storeService.OpenKVStore(ctx)
 	store := runtime.KVStoreAdapter(kvstore)
-	prefixStore := prefix.NewStore(store, []byte(watchedAddressStoreKeyPrefix))
-	return prefixStore.Has([]byte(target))
+	return prefix.NewStore(store, []byte(watchedAddressStoreKeyPrefix))
+}
+
+// unmarshalWatchedTarget decodes the WatchedTarget record of address, which
+// is empty if only the sentinel was stored.
+func (k Keeper) unmarshalWatchedTarget(address string, bz []byte) (types.WatchedTarget, error) {
+	target := types.WatchedTarget{Address: address}
+	if string(bz) == watchedAddressSentinel {
+		return target, nil
+	}
+	if err := k.cdc.Unmarshal(bz, &target); err != nil {
+		return target, err
+	}
+	target.Address = address
+	return target, nil
+}
+
+// GetWatchedTarget returns the WatchedTarget record of a watched address.
+func (k Keeper) GetWatchedTarget(ctx sdk.Context, address string) (types.WatchedTarget, bool, error) {
+	bz := k.watchedTargetStore(ctx).Get([]byte(address))
+	if bz == nil {
+		return types.WatchedTarget{}, false, nil
+	}
+	target, err := k.unmarshalWatchedTarget(address, bz)
+	return target, true, err
+}
+
+// SetWatchedTarget watches target.Address with the given record.
+func (k Keeper) SetWatchedTarget(ctx sdk.Context, target types.WatchedTarget) error {
+	bz, err := k.cdc.Marshal(&target)
+	if err != nil {
+		return err
+	}
+	k.watchedTargetStore(ctx).Set([]byte(target.Address), bz)
+	return nil
+}
+
+// GetWatchedTargets returns the WatchedTarget records of all watched
+// addresses.
+func (k Keeper) GetWatchedTargets(ctx sdk.Context) ([]types.WatchedTarget, error) {
+	targets := []types.WatchedTarget{}
+	iterator := k.watchedTargetStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		target, err := k.unmarshalWatchedTarget(string(iterator.Key()), iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// watchTarget starts watching address as of the current block, unless it is
+// already watched.
+func (k Keeper) watchTarget(ctx sdk.Context, address string) error {
+	if k.targetIsWatched(ctx, address) {
+		return nil
+	}
+	return k.SetWatchedTarget(ctx, types.WatchedTarget{Address: address, RegisterHeight: ctx.BlockHeight()})
+}
+
+type interceptKind int
+
+const (
+	interceptedRecv interceptKind = iota
+	interceptedAck
+	interceptedTimeout
+)
+
+// countIntercepted counts a packet intercepted for a watched address, logging
+// and skipping any failure.
+func (k Keeper) countIntercepted(ctx sdk.Context, address string, kind interceptKind) {
+	target, found, err := k.GetWatchedTarget(ctx, address)
+	if err != nil || !found {
+		k.logCountError(ctx, address, err)
+		return
+	}
+	switch kind {
+	case interceptedRecv:
+		target.RecvCount++
+	case interceptedAck:
+		target.AckCount++
+	case interceptedTimeout:
+		target.TimeoutCount++
+	}
+	k.logCountError(ctx, address, k.SetWatchedTarget(ctx, target))
+}
+
+// logCountError logs a failure to count a packet for a watched target.  The
+// counts are only diagnostics, so they must not fail the packet.
+func (k Keeper) logCountError(ctx sdk.Context, address string, err error) {
+	if err != nil {
+		ctx.Logger().Error("cannot count intercepted packet", "target", address, "err", err)
+	}
 }
 
 // GetWatchedAdresses returns the watched addresses from the keeper as a slice
 // of account addresses.
 func (k Keeper) GetWatchedAddresses(ctx sdk.Context) ([]sdk.AccAddress, error) {
-	kvstore := k.storeService.OpenKVStore(ctx)
-	store := runtime.KVStoreAdapter(kvstore)
 	addresses := make([]sdk.AccAddress, 0)
-	prefixStore := prefix.NewStore(store, []byte(watchedAddressStoreKeyPrefix))
-	iterator := storetypes.KVStorePrefixIterator(prefixStore, []byte{})
+	iterator := storetypes.KVStorePrefixIterator(k.watchedTargetStore(ctx), []byte{})
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		addr, err := sdk.AccAddressFromBech32(string(iterator.Key()))
@@ -390,15 +520,15 @@ func (k Keeper) GetWatchedAddresses(ctx sdk.Context) ([]sdk.AccAddress, error) {
 	return addresses, nil
 }
 
-// SetWatchedAddresses sets the watched addresses in the keeper from a slice of
-// SDK account addresses.
-func (k Keeper) SetWatchedAddresses(ctx sdk.Context, addresses []sdk.AccAddress) {
-	kvstore := k.storeService.OpenKVStore(ctx)
-	store := runtime.KVStoreAdapter(kvstore)
-	prefixStore := prefix.NewStore(store, []byte(watchedAddressStoreKeyPrefix))
+// SetWatchedAddresses watches the addresses in the keeper from a slice of SDK
+// account addresses.
+func (k Keeper) SetWatchedAddresses(ctx sdk.Context, addresses []sdk.AccAddress) error {
 	for _, addr := range addresses {
-		prefixStore.Set([]byte(addr.String()), []byte(watchedAddressSentinel))
+		if err := k.watchTarget(ctx, addr.String()); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 type registrationAction struct {
@@ -406,24 +536,37 @@ type registrationAction struct {
 	Target string `json:"target"`
 }
 
+func init() {
+	vm.RegisterPortMessage("vtransfer", registrationAction{})
+}
+
+// DescribePort implements the vm.PortDescriber interface, listing the
+// non-IBC_METHOD messages accepted by Receive.
+func (k Keeper) DescribePort() vm.PortCapabilities {
+	return vm.PortCapabilities{
+		Version: "1",
+		Methods: []string{"BRIDGE_TARGET_REGISTER", "BRIDGE_TARGET_UNREGISTER"},
+	}
+}
+
 // Receive implements the vm.PortHandler interface.
 func (k Keeper) Receive(cctx context.Context, jsonRequest string) (jsonReply string, err error) {
 	ctx := sdk.UnwrapSDKContext(cctx)
 	var msg registrationAction
-	if err := json.Unmarshal([]byte(jsonRequest), &msg); err != nil {
+	enc, err := vm.UnmarshalData(jsonRequest, &msg)
+	if err != nil {
 		return "", err
 	}
 
-	kvstore := k.storeService.OpenKVStore(ctx)
-	store := runtime.KVStoreAdapter(kvstore)
-	prefixStore := prefix.NewStore(store, []byte(watchedAddressStoreKeyPrefix))
 	switch msg.Type {
 	case "BRIDGE_TARGET_REGISTER":
-		prefixStore.Set([]byte(msg.Target), []byte(watchedAddressSentinel))
+		if err := k.watchTarget(ctx, msg.Target); err != nil {
+			return "", err
+		}
 	case "BRIDGE_TARGET_UNREGISTER":
-		prefixStore.Delete([]byte(msg.Target))
+		k.watchedTargetStore(ctx).Delete([]byte(msg.Target))
 	default:
 		return "", sdkioerrors.Wrapf(sdktypeserrors.ErrUnknownRequest, "unknown action type: %s", msg.Type)
 	}
-	return "true", nil
+	return vm.MarshalData(enc, true)
 }
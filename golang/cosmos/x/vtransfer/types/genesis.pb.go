@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// The list of account addresses that are being watched by the VM.
 	WatchedAddresses []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,rep,name=watched_addresses,json=watchedAddresses,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"watched_addresses" yaml:"watched_addresses"`
+	// The registration heights and packet counts of the watched addresses.
+	WatchedTargets []WatchedTarget `protobuf:"bytes,2,rep,name=watched_targets,json=watchedTargets,proto3" json:"watched_targets" yaml:"watched_targets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +72,13 @@ func (m *GenesisState) GetWatchedAddresses() []github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+func (m *GenesisState) GetWatchedTargets() []WatchedTarget {
+	if m != nil {
+		return m.WatchedTargets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "agoric.vtransfer.GenesisState")
 }
@@ -77,23 +86,27 @@ func init() {
 func init() { proto.RegisterFile("agoric/vtransfer/genesis.proto", fileDescriptor_fd0b59a10ad6824e) }
 
 var fileDescriptor_fd0b59a10ad6824e = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4c, 0xcf, 0x2f,
-	0xca, 0x4c, 0xd6, 0x2f, 0x2b, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0xd2, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0xd2, 0x32,
-	0x46, 0x2e, 0x1e, 0x77, 0x88, 0xce, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0xa1, 0x7e, 0x46, 0x2e, 0xc1,
-	0xf2, 0xc4, 0x92, 0xe4, 0x8c, 0xd4, 0x94, 0xf8, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xd4,
-	0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x1e, 0xa7, 0xa4, 0x57, 0xf7, 0xe4, 0x31, 0x25, 0x3f, 0xdd,
-	0x93, 0x97, 0xa8, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0x90, 0x52, 0xfa, 0x75, 0x4f, 0x5e, 0x37,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf,
-	0x18, 0x4a, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0xeb, 0x39, 0x26, 0x27,
-	0x3b, 0x42, 0xf4, 0x04, 0x09, 0x40, 0x0d, 0x71, 0x84, 0x99, 0x61, 0xc5, 0xf2, 0x62, 0x81, 0x3c,
-	0x83, 0x53, 0xd8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xd9, 0x20, 0x59,
-	0xe0, 0x08, 0x09, 0x15, 0x88, 0xe7, 0xc1, 0x16, 0xa4, 0xe7, 0xe7, 0x24, 0xe6, 0xa5, 0xc3, 0x6c,
-	0xae, 0x40, 0x0a, 0x30, 0xb0, 0xd5, 0x49, 0x6c, 0xe0, 0x70, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x78, 0x52, 0xc9, 0xd5, 0x51, 0x01, 0x00, 0x00,
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x4e, 0x02, 0x41,
+	0x10, 0x86, 0xef, 0xc0, 0x58, 0x9c, 0x44, 0x91, 0x18, 0x43, 0x28, 0x76, 0xc9, 0x55, 0x34, 0xec,
+	0x45, 0xed, 0x88, 0xcd, 0xd1, 0xd8, 0xa3, 0xd1, 0xc4, 0xc6, 0x2c, 0x7b, 0xeb, 0x42, 0x04, 0x96,
+	0xec, 0xac, 0x22, 0xf1, 0x21, 0xf4, 0x11, 0x4c, 0x7c, 0x19, 0x4a, 0x4a, 0xab, 0x8d, 0x81, 0xc6,
+	0x50, 0x52, 0x5a, 0x19, 0x6f, 0xef, 0xc8, 0xc9, 0x55, 0x33, 0xc9, 0xff, 0xcf, 0x97, 0xf9, 0x67,
+	0x3c, 0x44, 0x85, 0x54, 0x7d, 0x16, 0x3c, 0x69, 0x45, 0x47, 0x70, 0xcf, 0x55, 0x20, 0xf8, 0x88,
+	0x43, 0x1f, 0xc8, 0x58, 0x49, 0x2d, 0x2b, 0x65, 0xab, 0x93, 0x8d, 0x5e, 0x3b, 0x12, 0x52, 0xc8,
+	0x58, 0x0c, 0xfe, 0x3a, 0xeb, 0xab, 0xd5, 0x73, 0x9c, 0x4d, 0x67, 0x1d, 0xfe, 0x47, 0xc1, 0x2b,
+	0x5d, 0x58, 0xf6, 0xa5, 0xa6, 0x9a, 0x57, 0x5e, 0x5d, 0xef, 0x70, 0x42, 0x35, 0xeb, 0xf1, 0xe8,
+	0x8e, 0x46, 0x91, 0xe2, 0x00, 0x1c, 0xaa, 0x6e, 0xbd, 0xd8, 0x28, 0xb5, 0xbb, 0x2b, 0x83, 0xf3,
+	0xe2, 0xda, 0xe0, 0xea, 0x94, 0x0e, 0x07, 0x2d, 0x3f, 0x27, 0xf9, 0x3f, 0x06, 0x37, 0x45, 0x5f,
+	0xf7, 0x1e, 0xbb, 0x84, 0xc9, 0x61, 0xc0, 0x24, 0x0c, 0x25, 0x24, 0xa5, 0x09, 0xd1, 0x43, 0xa0,
+	0xa7, 0x63, 0x0e, 0x24, 0x64, 0x2c, 0xb4, 0x33, 0x9d, 0x72, 0x02, 0x09, 0x53, 0x46, 0xe5, 0xc5,
+	0x3b, 0x48, 0xc1, 0x9a, 0x2a, 0xc1, 0x35, 0x54, 0x0b, 0xf5, 0x62, 0x63, 0xef, 0x14, 0x93, 0xed,
+	0x33, 0x90, 0x1b, 0x6b, 0xbc, 0x8a, 0x7d, 0xed, 0x93, 0x99, 0xc1, 0xce, 0xca, 0xe0, 0xed, 0xf9,
+	0xb5, 0xc1, 0xc7, 0xff, 0x37, 0x4e, 0x04, 0xbf, 0xb3, 0x3f, 0xc9, 0x12, 0xa0, 0xb5, 0xf3, 0xfd,
+	0x8e, 0x9d, 0xf6, 0xf5, 0x6c, 0x81, 0xdc, 0xf9, 0x02, 0xb9, 0x5f, 0x0b, 0xe4, 0xbe, 0x2d, 0x91,
+	0x33, 0x5f, 0x22, 0xe7, 0x73, 0x89, 0x9c, 0xdb, 0xf3, 0x4c, 0xba, 0xd0, 0x1e, 0xdb, 0x2e, 0x15,
+	0xa7, 0x13, 0x72, 0x40, 0x47, 0x22, 0x8d, 0xfd, 0x9c, 0xf9, 0x43, 0x9c, 0xbb, 0xbb, 0x1b, 0x3f,
+	0xe1, 0xec, 0x77, 0x00, 0x89, 0x31, 0x62, 0x41, 0xf0, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +129,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WatchedTargets) > 0 {
+		for iNdEx := len(m.WatchedTargets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WatchedTargets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.WatchedAddresses) > 0 {
 		for iNdEx := len(m.WatchedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.WatchedAddresses[iNdEx])
@@ -151,6 +178,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.WatchedTargets) > 0 {
+		for _, e := range m.WatchedTargets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -221,6 +254,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			m.WatchedAddresses = append(m.WatchedAddresses, make([]byte, postIndex-iNdEx))
 			copy(m.WatchedAddresses[len(m.WatchedAddresses)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchedTargets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WatchedTargets = append(m.WatchedTargets, WatchedTarget{})
+			if err := m.WatchedTargets[len(m.WatchedTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
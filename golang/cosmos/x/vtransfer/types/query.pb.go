@@ -0,0 +1,675 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: agoric/vtransfer/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryWatchedTargetsRequest is the request type for the Query/WatchedTargets
+// RPC method.
+type QueryWatchedTargetsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWatchedTargetsRequest) Reset()         { *m = QueryWatchedTargetsRequest{} }
+func (m *QueryWatchedTargetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchedTargetsRequest) ProtoMessage()    {}
+func (*QueryWatchedTargetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_541c815fdcf80709, []int{0}
+}
+func (m *QueryWatchedTargetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchedTargetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchedTargetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWatchedTargetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchedTargetsRequest.Merge(m, src)
+}
+func (m *QueryWatchedTargetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchedTargetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchedTargetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWatchedTargetsRequest proto.InternalMessageInfo
+
+func (m *QueryWatchedTargetsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWatchedTargetsResponse is the response type for the
+// Query/WatchedTargets RPC method.
+type QueryWatchedTargetsResponse struct {
+	Targets    []WatchedTarget     `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWatchedTargetsResponse) Reset()         { *m = QueryWatchedTargetsResponse{} }
+func (m *QueryWatchedTargetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchedTargetsResponse) ProtoMessage()    {}
+func (*QueryWatchedTargetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_541c815fdcf80709, []int{1}
+}
+func (m *QueryWatchedTargetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchedTargetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchedTargetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWatchedTargetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchedTargetsResponse.Merge(m, src)
+}
+func (m *QueryWatchedTargetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchedTargetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchedTargetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWatchedTargetsResponse proto.InternalMessageInfo
+
+func (m *QueryWatchedTargetsResponse) GetTargets() []WatchedTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *QueryWatchedTargetsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryWatchedTargetsRequest)(nil), "agoric.vtransfer.QueryWatchedTargetsRequest")
+	proto.RegisterType((*QueryWatchedTargetsResponse)(nil), "agoric.vtransfer.QueryWatchedTargetsResponse")
+}
+
+func init() { proto.RegisterFile("agoric/vtransfer/query.proto", fileDescriptor_541c815fdcf80709) }
+
+var fileDescriptor_541c815fdcf80709 = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x4b, 0x02, 0x41,
+	0x18, 0xc7, 0x77, 0xec, 0x0d, 0x46, 0x88, 0x58, 0x3a, 0xc8, 0x26, 0xab, 0x19, 0x94, 0x45, 0xce,
+	0xa0, 0x5d, 0x83, 0xc8, 0x43, 0x5d, 0x4b, 0xa2, 0xa0, 0x4b, 0xcc, 0xae, 0xd3, 0xb8, 0xa4, 0xfb,
+	0xac, 0x3b, 0xa3, 0xe5, 0xb5, 0x4f, 0x10, 0x74, 0xe9, 0xdc, 0xa5, 0xaf, 0xe2, 0x51, 0xe8, 0xd2,
+	0x29, 0x42, 0xfb, 0x20, 0xe1, 0xce, 0x66, 0x9a, 0x45, 0xdd, 0x86, 0xfd, 0xbf, 0x3c, 0xbf, 0x99,
+	0x7d, 0x70, 0x9a, 0x09, 0x08, 0x3d, 0x97, 0xb6, 0x55, 0xc8, 0x7c, 0x79, 0xc9, 0x43, 0xda, 0x6c,
+	0xf1, 0xb0, 0x43, 0x82, 0x10, 0x14, 0x98, 0x4b, 0x5a, 0x25, 0x23, 0xd5, 0x5a, 0x16, 0x20, 0x20,
+	0x12, 0xe9, 0xf0, 0xa4, 0x7d, 0x56, 0x5a, 0x00, 0x88, 0x3a, 0xa7, 0x2c, 0xf0, 0x28, 0xf3, 0x7d,
+	0x50, 0x4c, 0x79, 0xe0, 0xcb, 0x58, 0xdd, 0x72, 0x41, 0x36, 0x40, 0x52, 0x87, 0x49, 0xae, 0xeb,
+	0x69, 0xbb, 0xe8, 0x70, 0xc5, 0x8a, 0x34, 0x60, 0xc2, 0xf3, 0x23, 0x73, 0xec, 0xcd, 0x4e, 0xf1,
+	0x8c, 0x4e, 0xda, 0x91, 0xab, 0x62, 0xeb, 0x78, 0xd8, 0x71, 0xc6, 0x94, 0x5b, 0xe3, 0xd5, 0x13,
+	0x16, 0x0a, 0xae, 0x64, 0x85, 0x37, 0x5b, 0x5c, 0x2a, 0xf3, 0x00, 0xe3, 0xaf, 0xce, 0x14, 0xca,
+	0xa2, 0x7c, 0xb2, 0xb4, 0x4e, 0x34, 0x00, 0x19, 0x02, 0x10, 0x7d, 0xbf, 0x18, 0x80, 0x1c, 0x31,
+	0xc1, 0xe3, 0x6c, 0x65, 0x2c, 0x99, 0x7b, 0x42, 0x78, 0xe5, 0xc7, 0x31, 0x32, 0x00, 0x5f, 0x72,
+	0x73, 0x0f, 0x2f, 0x28, 0xfd, 0x29, 0x85, 0xb2, 0x33, 0xf9, 0x64, 0x29, 0x43, 0xbe, 0xbf, 0x15,
+	0x99, 0x88, 0x96, 0x67, 0xbb, 0xaf, 0x19, 0xa3, 0xf2, 0x99, 0x32, 0x0f, 0x27, 0x40, 0x13, 0x11,
+	0xe8, 0xc6, 0x9f, 0xa0, 0x7a, 0xfa, 0x38, 0x69, 0xe9, 0x11, 0xe1, 0xb9, 0x88, 0xd4, 0x7c, 0x40,
+	0x78, 0x71, 0x12, 0xd7, 0xdc, 0x9e, 0xa6, 0xfa, 0xfd, 0xf1, 0xac, 0xc2, 0x3f, 0xdd, 0x9a, 0x22,
+	0xb7, 0x79, 0xfb, 0xfc, 0x7e, 0x9f, 0x58, 0x33, 0x57, 0xe9, 0xd4, 0x4f, 0xbb, 0xd6, 0x89, 0x8b,
+	0xf8, 0xb6, 0xe5, 0xd3, 0x6e, 0xdf, 0x46, 0xbd, 0xbe, 0x8d, 0xde, 0xfa, 0x36, 0xba, 0x1b, 0xd8,
+	0x46, 0x6f, 0x60, 0x1b, 0x2f, 0x03, 0xdb, 0x38, 0xdf, 0x15, 0x9e, 0xaa, 0xb5, 0x1c, 0xe2, 0x42,
+	0x83, 0xee, 0xeb, 0x1a, 0xdd, 0x56, 0x90, 0xd5, 0x2b, 0x2a, 0xa0, 0xce, 0x7c, 0x41, 0xe3, 0x05,
+	0xba, 0x19, 0x9b, 0xa0, 0x3a, 0x01, 0x97, 0xce, 0x7c, 0xb4, 0x13, 0x3b, 0x1f, 0x03, 0x00, 0x16,
+	0x66, 0x43, 0x10, 0xc7, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// WatchedTargets queries the addresses registered by the VM, with counts of
+	// the packets intercepted for each.
+	WatchedTargets(ctx context.Context, in *QueryWatchedTargetsRequest, opts ...grpc.CallOption) (*QueryWatchedTargetsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) WatchedTargets(ctx context.Context, in *QueryWatchedTargetsRequest, opts ...grpc.CallOption) (*QueryWatchedTargetsResponse, error) {
+	out := new(QueryWatchedTargetsResponse)
+	err := c.cc.Invoke(ctx, "/agoric.vtransfer.Query/WatchedTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// WatchedTargets queries the addresses registered by the VM, with counts of
+	// the packets intercepted for each.
+	WatchedTargets(context.Context, *QueryWatchedTargetsRequest) (*QueryWatchedTargetsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) WatchedTargets(ctx context.Context, req *QueryWatchedTargetsRequest) (*QueryWatchedTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchedTargets not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_WatchedTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWatchedTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WatchedTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agoric.vtransfer.Query/WatchedTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WatchedTargets(ctx, req.(*QueryWatchedTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "agoric.vtransfer.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WatchedTargets",
+			Handler:    _Query_WatchedTargets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agoric/vtransfer/query.proto",
+}
+
+func (m *QueryWatchedTargetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchedTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchedTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchedTargetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchedTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchedTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWatchedTargetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWatchedTargetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryWatchedTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchedTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchedTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWatchedTargetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchedTargetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchedTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, WatchedTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
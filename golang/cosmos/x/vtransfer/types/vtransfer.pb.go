@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: agoric/vtransfer/vtransfer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WatchedTarget is an address registered by the VM to intercept the transfers
+// that it sends or receives, with counts of the packets intercepted for it.
+type WatchedTarget struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// register_height is the block height at which the VM registered the
+	// address, or zero if it was registered before heights were recorded.
+	RegisterHeight int64 `protobuf:"varint,2,opt,name=register_height,json=registerHeight,proto3" json:"register_height,omitempty"`
+	// recv_count counts the packets received by the address whose
+	// acknowledgement was delegated to the VM.
+	RecvCount uint64 `protobuf:"varint,3,opt,name=recv_count,json=recvCount,proto3" json:"recv_count,omitempty"`
+	// ack_count counts the acknowledgements of packets sent by the address.
+	AckCount uint64 `protobuf:"varint,4,opt,name=ack_count,json=ackCount,proto3" json:"ack_count,omitempty"`
+	// timeout_count counts the timeouts of packets sent by the address.
+	TimeoutCount uint64 `protobuf:"varint,5,opt,name=timeout_count,json=timeoutCount,proto3" json:"timeout_count,omitempty"`
+}
+
+func (m *WatchedTarget) Reset()         { *m = WatchedTarget{} }
+func (m *WatchedTarget) String() string { return proto.CompactTextString(m) }
+func (*WatchedTarget) ProtoMessage()    {}
+func (*WatchedTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_885c0c337eee0359, []int{0}
+}
+func (m *WatchedTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchedTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchedTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchedTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchedTarget.Merge(m, src)
+}
+func (m *WatchedTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchedTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchedTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchedTarget proto.InternalMessageInfo
+
+func (m *WatchedTarget) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WatchedTarget) GetRegisterHeight() int64 {
+	if m != nil {
+		return m.RegisterHeight
+	}
+	return 0
+}
+
+func (m *WatchedTarget) GetRecvCount() uint64 {
+	if m != nil {
+		return m.RecvCount
+	}
+	return 0
+}
+
+func (m *WatchedTarget) GetAckCount() uint64 {
+	if m != nil {
+		return m.AckCount
+	}
+	return 0
+}
+
+func (m *WatchedTarget) GetTimeoutCount() uint64 {
+	if m != nil {
+		return m.TimeoutCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*WatchedTarget)(nil), "agoric.vtransfer.WatchedTarget")
+}
+
+func init() { proto.RegisterFile("agoric/vtransfer/vtransfer.proto", fileDescriptor_885c0c337eee0359) }
+
+var fileDescriptor_885c0c337eee0359 = []byte{
+	// 262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x46, 0x6b, 0x5a, 0x7e, 0x62, 0x51, 0x40, 0x99, 0x22, 0x21, 0xac, 0x08, 0x06, 0xb2, 0x10,
+	0x0f, 0xac, 0x2c, 0xc0, 0xc2, 0x1c, 0x21, 0x90, 0x58, 0x2a, 0xd7, 0xb9, 0x38, 0x56, 0x48, 0x5c,
+	0xd9, 0x37, 0x15, 0xbc, 0x05, 0xef, 0xc2, 0x4b, 0x30, 0x76, 0x64, 0x44, 0xc9, 0x8b, 0xa0, 0x3a,
+	0x29, 0xb0, 0xd9, 0xe7, 0x3b, 0xcb, 0x3d, 0x34, 0x16, 0xca, 0x58, 0x2d, 0xf9, 0x12, 0xad, 0xa8,
+	0xdd, 0x33, 0xd8, 0xbf, 0x57, 0xba, 0xb0, 0x06, 0x4d, 0x78, 0xd4, 0x1b, 0xe9, 0x2f, 0x3f, 0xfd,
+	0x20, 0x74, 0xfa, 0x28, 0x50, 0x16, 0x90, 0xdf, 0x0b, 0xab, 0x00, 0xc3, 0x88, 0xee, 0x8a, 0x3c,
+	0xb7, 0xe0, 0x5c, 0x44, 0x62, 0x92, 0x04, 0xd9, 0xe6, 0x1b, 0x9e, 0xd3, 0x43, 0x0b, 0x4a, 0x3b,
+	0x04, 0x3b, 0x2b, 0x40, 0xab, 0x02, 0xa3, 0xad, 0x98, 0x24, 0xe3, 0xec, 0x60, 0x83, 0xef, 0x3c,
+	0x0d, 0x4f, 0x28, 0xb5, 0x20, 0x97, 0x33, 0x69, 0x9a, 0x1a, 0xa3, 0x71, 0x4c, 0x92, 0x49, 0x16,
+	0xac, 0xc9, 0xed, 0x1a, 0x84, 0xc7, 0x34, 0x10, 0xb2, 0x1c, 0xd6, 0x89, 0x5f, 0xf7, 0x84, 0x2c,
+	0xfb, 0xf1, 0x8c, 0x4e, 0x51, 0x57, 0x60, 0x1a, 0x1c, 0x84, 0x6d, 0x2f, 0xec, 0x0f, 0xd0, 0x4b,
+	0x37, 0x0f, 0x9f, 0x2d, 0x23, 0xab, 0x96, 0x91, 0xef, 0x96, 0x91, 0xf7, 0x8e, 0x8d, 0x56, 0x1d,
+	0x1b, 0x7d, 0x75, 0x6c, 0xf4, 0x74, 0xa5, 0x34, 0x16, 0xcd, 0x3c, 0x95, 0xa6, 0xe2, 0xd7, 0x7d,
+	0x8e, 0xfe, 0xe6, 0x0b, 0x97, 0x97, 0x5c, 0x99, 0x17, 0x51, 0x2b, 0x2e, 0x8d, 0xab, 0x8c, 0xe3,
+	0xaf, 0xff, 0x4a, 0xe1, 0xdb, 0x02, 0xdc, 0x7c, 0xc7, 0x67, 0xba, 0xfc, 0x19, 0x00, 0xd8, 0xeb,
+	0x10, 0xee, 0x4a, 0x01, 0x00, 0x00,
+}
+
+func (m *WatchedTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchedTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchedTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutCount != 0 {
+		i = encodeVarintVtransfer(dAtA, i, uint64(m.TimeoutCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AckCount != 0 {
+		i = encodeVarintVtransfer(dAtA, i, uint64(m.AckCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecvCount != 0 {
+		i = encodeVarintVtransfer(dAtA, i, uint64(m.RecvCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RegisterHeight != 0 {
+		i = encodeVarintVtransfer(dAtA, i, uint64(m.RegisterHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVtransfer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVtransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVtransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WatchedTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVtransfer(uint64(l))
+	}
+	if m.RegisterHeight != 0 {
+		n += 1 + sovVtransfer(uint64(m.RegisterHeight))
+	}
+	if m.RecvCount != 0 {
+		n += 1 + sovVtransfer(uint64(m.RecvCount))
+	}
+	if m.AckCount != 0 {
+		n += 1 + sovVtransfer(uint64(m.AckCount))
+	}
+	if m.TimeoutCount != 0 {
+		n += 1 + sovVtransfer(uint64(m.TimeoutCount))
+	}
+	return n
+}
+
+func sovVtransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVtransfer(x uint64) (n int) {
+	return sovVtransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WatchedTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVtransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchedTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchedTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterHeight", wireType)
+			}
+			m.RegisterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisterHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvCount", wireType)
+			}
+			m.RecvCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckCount", wireType)
+			}
+			m.AckCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutCount", wireType)
+			}
+			m.TimeoutCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVtransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVtransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVtransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVtransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVtransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVtransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVtransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVtransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVtransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVtransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVtransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVtransfer = fmt.Errorf("proto: unexpected end of group")
+)
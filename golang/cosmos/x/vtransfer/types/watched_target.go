@@ -0,0 +1,15 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless validation of a WatchedTarget.
+func (t WatchedTarget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(t.Address); err != nil {
+		return fmt.Errorf("cannot convert watched target %q to address: %s", t.Address, err)
+	}
+	return nil
+}
@@ -48,6 +48,12 @@ import {
   makeReadCachingStorage,
 } from './helpers/bufferedStorage.js';
 import stringify from './helpers/json-stable-stringify.js';
+import {
+  decodeBridgeData,
+  encodeBridgeData,
+  extractPortEncodings,
+  negotiateEncoding,
+} from './helpers/bridge-encoding.js';
 import { launch, launchAndShareInternals } from './launch-chain.js';
 import { makeProcessValue } from './helpers/process-value.js';
 import {
@@ -306,6 +312,12 @@ export const makeLaunchChain = (
   const launchChain = async initAction => {
     const { XSNAP_KEEP_SNAPSHOTS = '', NODE_HEAP_SNAPSHOTS = '-1' } = env;
     const portNums = extractPortNums(initAction);
+    const portEncodings = extractPortEncodings(initAction);
+    // Messages to ports that accept it use AG_COSMOS_BRIDGE_ENCODING (such as
+    // "cbor") rather than JSON.
+    const preferredEncoding = /** @type {any} */ (
+      env.AG_COSMOS_BRIDGE_ENCODING || 'json'
+    );
 
     /** @type {CosmosSwingsetConfig} */
     const swingsetConfig = harden(initAction.resolvedConfig || {});
@@ -403,11 +415,16 @@ export const makeLaunchChain = (
           `warning: doOutboundBridge called before AG_COSMOS_INIT gave us ${portKey}`,
         );
       }
-      const respStr = chainSend(portNum, stringify(msg));
+      const encoding = negotiateEncoding(
+        portEncodings,
+        portNum,
+        preferredEncoding,
+      );
+      const respStr = chainSend(portNum, encodeBridgeData(encoding, msg));
       try {
-        return JSON.parse(respStr);
+        return decodeBridgeData(respStr);
       } catch (e) {
-        throw Fail`cannot JSON.parse(${JSON.stringify(respStr)}): ${e}`;
+        throw Fail`cannot decode(${JSON.stringify(respStr)}): ${e}`;
       }
     }
 
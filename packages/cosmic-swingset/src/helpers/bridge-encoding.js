@@ -0,0 +1,87 @@
+// @ts-check
+
+import { Fail, q } from '@endo/errors';
+import { decode, encode } from 'cbor2';
+
+import stringify from './json-stable-stringify.js';
+
+/**
+ * @typedef {'json' | 'cbor'} BridgeEncoding
+ */
+
+/**
+ * The self-described CBOR tag (RFC 8949 section 3.4.6) that begins every CBOR
+ * item sent over the bridge.
+ */
+const CBOR_TAG = harden([0xd9, 0xd9, 0xf7]);
+
+/**
+ * CBOR crosses the bridge as the base64 of the tagged item, since neither the
+ * C strings of libdaemon nor JavaScript strings can carry arbitrary bytes.
+ * This is the base64 of CBOR_TAG, which no JSON text can begin with.
+ */
+export const CBOR_DATA_PREFIX = '2dn3';
+
+/**
+ * Capture the encodings that each port accepts besides JSON, as described in
+ * app.go/cosmosInitAction.
+ *
+ * @param {{ portEncodings?: Record<string, string[]> }} action
+ * @returns {Map<number, string[]>}
+ */
+export const extractPortEncodings = action => {
+  const portEncodings = new Map();
+  for (const [port, encodings] of Object.entries(action.portEncodings || {})) {
+    portEncodings.set(Number(port), harden([...encodings]));
+  }
+  return portEncodings;
+};
+
+/**
+ * Choose the encoding of messages to a port: the preferred one if the port
+ * accepts it, else JSON.
+ *
+ * @param {Map<number, string[]>} portEncodings
+ * @param {number} portNum
+ * @param {BridgeEncoding} [preferred]
+ * @returns {BridgeEncoding}
+ */
+export const negotiateEncoding = (portEncodings, portNum, preferred = 'json') =>
+  preferred !== 'json' && portEncodings.get(portNum)?.includes(preferred)
+    ? preferred
+    : 'json';
+
+/**
+ * @param {BridgeEncoding} encoding
+ * @param {unknown} msg
+ * @returns {string}
+ */
+export const encodeBridgeData = (encoding, msg) => {
+  switch (encoding) {
+    case 'json':
+      return stringify(msg);
+    case 'cbor': {
+      const item = encode(msg);
+      const bytes = new Uint8Array(CBOR_TAG.length + item.length);
+      bytes.set(CBOR_TAG, 0);
+      bytes.set(item, CBOR_TAG.length);
+      return Buffer.from(bytes).toString('base64');
+    }
+    default:
+      throw Fail`unknown bridge encoding ${q(encoding)}`;
+  }
+};
+
+/**
+ * Decode a message in whatever encoding it has.
+ *
+ * @param {string} data
+ * @returns {any}
+ */
+export const decodeBridgeData = data => {
+  if (!data.startsWith(CBOR_DATA_PREFIX)) {
+    return JSON.parse(data);
+  }
+  const bytes = Buffer.from(data, 'base64');
+  return decode(bytes.subarray(CBOR_TAG.length));
+};
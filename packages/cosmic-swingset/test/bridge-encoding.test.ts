@@ -0,0 +1,31 @@
+import '@endo/init/debug.js';
+
+import test from 'ava';
+import {
+  CBOR_DATA_PREFIX,
+  decodeBridgeData,
+  encodeBridgeData,
+  extractPortEncodings,
+  negotiateEncoding,
+} from '../src/helpers/bridge-encoding.js';
+
+test('negotiate the encodings advertised by cosmosInitAction', t => {
+  const portEncodings = extractPortEncodings({
+    portEncodings: { '5': ['json', 'cbor'] },
+  });
+  t.is(negotiateEncoding(portEncodings, 5, 'cbor'), 'cbor');
+  t.is(negotiateEncoding(portEncodings, 6, 'cbor'), 'json');
+  t.is(negotiateEncoding(portEncodings, 5), 'json');
+  t.is(negotiateEncoding(extractPortEncodings({}), 5, 'cbor'), 'json');
+});
+
+test('CBOR data is base64 without NUL', t => {
+  const msg = { type: 'VBANK_GET_BALANCE', data: new Uint8Array([0, 1, 0]) };
+  const data = encodeBridgeData('cbor', msg);
+  t.true(data.startsWith(CBOR_DATA_PREFIX));
+  t.false(data.includes('\0'));
+  t.deepEqual(decodeBridgeData(data), msg);
+
+  t.is(encodeBridgeData('json', { type: 'X' }), '{"type":"X"}');
+  t.deepEqual(decodeBridgeData('{"type":"X"}'), { type: 'X' });
+});
@@ -234,7 +234,22 @@ export type IBCBridgeEvent =
   | 'receivePacket'
   | 'acknowledgementPacket'
   | 'timeoutPacket'
-  | 'sendPacket';
+  | 'sendPacket'
+  | 'packetInFlight';
+
+/**
+ * The typed payload of the target's address hook, as delivered by `vtransfer`
+ * when the target's address in the packet has a valid hook query.  The query
+ * keys `v`, `dest`, `memo`, `fwdChannel` and `fwdReceiver` are decoded into
+ * fields; the others are left in `extra`, where every value is an array.
+ */
+export type AddressHookPayload = {
+  version: number;
+  destination?: string;
+  memo?: string;
+  forward?: { channel: IBCChannelID; receiver: string };
+  extra?: Record<string, string[]>;
+};
 
 type IBCPacketEvents = {
   channelOpenInit: ConnectingInfo;
@@ -248,18 +263,23 @@ type IBCPacketEvents = {
     acknowledgement: Bytes;
     packet: IBCPacket;
     relayer: string; // chain address
+    hook?: AddressHookPayload;
   };
   writeAcknowledgement: {
     acknowledgement: Bytes;
     packet: IBCPacket;
     relayer: string; // chain address
+    hook?: AddressHookPayload;
   };
   timeoutPacket: {
     packet: IBCPacket;
+    hook?: AddressHookPayload;
   };
   channelCloseInit: { channelID: IBCChannelID; portID: IBCPortID };
   channelCloseConfirm: { channelID: IBCChannelID; portID: IBCPortID };
   sendPacket: { relativeTimeoutNs: bigint; packet: IBCPacket };
+  /** pushed by a resync for each packet awaiting acknowledgement or timeout */
+  packetInFlight: { packet: IBCPacket; sendHeight: number };
 };
 
 /**
@@ -293,7 +313,10 @@ export type IBCDowncallMethod =
   | 'startChannelCloseInit'
   | 'bindPort'
   | 'timeoutExecuted'
-  | 'initOpenExecuted';
+  | 'initOpenExecuted'
+  | 'ackOpenExecuted'
+  | 'resyncPackets'
+  | 'revokePort';
 
 type IBCMethodEvents = {
   sendPacket: SendPacketDownCall;
@@ -307,14 +330,26 @@ type IBCMethodEvents = {
     packet: Pick<IBCPacket, 'source_port' | 'source_channel'>;
   };
   bindPort: { packet: { source_port: IBCPortID } };
+  revokePort: { packet: { source_port: IBCPortID } };
   timeoutExecuted: {
     packet: IBCPacket;
   };
   initOpenExecuted: ChannelOpenAckDowncall;
+  ackOpenExecuted: {
+    packet: Pick<IBCPacket, 'source_port' | 'source_channel'>;
+    /** if nonempty, rejects the counterparty version and closes the channel */
+    reason?: string;
+  };
+  /** restricted to a port, or a channel of it, if given */
+  resyncPackets: {
+    packet: Partial<Pick<IBCPacket, 'source_port' | 'source_channel'>>;
+  };
 };
 
 type IBCMethodReturns = {
   sendPacket: Required<IBCPacket>;
+  /** the number of packetInFlight events pushed */
+  resyncPackets: number;
 };
 
 export type IBCMethod<M extends IBCDowncallMethod> = {
@@ -357,6 +392,8 @@ type ChannelOpenAckDowncall = ChannelOpenDowncallBase & {
 type SendPacketDownCall = {
   packet: IBCPacket;
   relativeTimeoutNs: bigint;
+  /** the listener to notify of the packet's fate, if not the port's handler */
+  target?: string;
 };
 
 /**